@@ -0,0 +1,19 @@
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// These policy types are not yet defined in the AWS SDK for Go.
+const (
+	PolicyTypeDeclarativePolicyEc2  = "DECLARATIVE_POLICY_EC2"
+	PolicyTypeResourceControlPolicy = "RESOURCE_CONTROL_POLICY"
+)
+
+func PolicyType_Values() []string {
+	return append(
+		organizations.PolicyType_Values(),
+		PolicyTypeDeclarativePolicyEc2,
+		PolicyTypeResourceControlPolicy,
+	)
+}
@@ -0,0 +1,17 @@
+package quicksight
+
+const (
+	MemberTypeAnalysis   = "ANALYSIS"
+	MemberTypeDashboard  = "DASHBOARD"
+	MemberTypeDataset    = "DATASET"
+	MemberTypeDatasource = "DATASOURCE"
+)
+
+func MemberType_Values() []string {
+	return []string{
+		MemberTypeAnalysis,
+		MemberTypeDashboard,
+		MemberTypeDataset,
+		MemberTypeDatasource,
+	}
+}
@@ -1,8 +1,13 @@
 package finder
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func GroupMembership(conn *quicksight.QuickSight, listInput *quicksight.ListGroupMembershipsInput, userName string) (bool, error) {
@@ -31,3 +36,122 @@ func GroupMembership(conn *quicksight.QuickSight, listInput *quicksight.ListGrou
 
 	return found, nil
 }
+
+func FolderByAccountIDAndFolderID(conn *quicksight.QuickSight, awsAccountID, folderID string) (*quicksight.Folder, error) {
+	input := &quicksight.DescribeFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolder(input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Folder == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Folder, nil
+}
+
+func FolderPermissionsByAccountIDAndFolderID(conn *quicksight.QuickSight, awsAccountID, folderID string) ([]*quicksight.ResourcePermission, error) {
+	input := &quicksight.DescribeFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolderPermissions(input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Permissions, nil
+}
+
+func FolderMembershipByID(conn *quicksight.QuickSight, awsAccountID, folderID, memberType, memberID string) (*quicksight.MemberIdArnPair, error) {
+	input := &quicksight.ListFolderMembersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	var result *quicksight.MemberIdArnPair
+
+	for {
+		output, err := conn.ListFolderMembers(input)
+
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, member := range output.FolderMemberList {
+			if aws.StringValue(member.MemberId) != memberID {
+				continue
+			}
+
+			// Members of different types can share an ID, the member ARN resource identifies the type.
+			memberARN, err := arn.Parse(aws.StringValue(member.MemberArn))
+
+			if err != nil {
+				return nil, err
+			}
+
+			if strings.HasPrefix(memberARN.Resource, strings.ToLower(memberType)+"/") {
+				result = member
+				break
+			}
+		}
+
+		if result != nil || aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
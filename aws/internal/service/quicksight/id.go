@@ -0,0 +1,44 @@
+package quicksight
+
+import (
+	"fmt"
+	"strings"
+)
+
+const folderResourceIDSeparator = ","
+
+func FolderCreateResourceID(awsAccountID, folderID string) string {
+	parts := []string{awsAccountID, folderID}
+	id := strings.Join(parts, folderResourceIDSeparator)
+
+	return id
+}
+
+func FolderParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, folderResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sFOLDER_ID", id, folderResourceIDSeparator)
+}
+
+const folderMembershipResourceIDSeparator = ","
+
+func FolderMembershipCreateResourceID(awsAccountID, folderID, memberType, memberID string) string {
+	parts := []string{awsAccountID, folderID, memberType, memberID}
+	id := strings.Join(parts, folderMembershipResourceIDSeparator)
+
+	return id
+}
+
+func FolderMembershipParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, folderMembershipResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sFOLDER_ID%[2]sMEMBER_TYPE%[2]sMEMBER_ID", id, folderMembershipResourceIDSeparator)
+}
@@ -0,0 +1,127 @@
+package quicksight_test
+
+import (
+	"testing"
+
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+)
+
+func TestFolderParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectError          bool
+		ExpectedAwsAccountID string
+		ExpectedFolderID     string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing folder ID",
+			InputID:     "123456789012,",
+			ExpectError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfquicksight.FolderCreateResourceID("123456789012", "folderID"),
+			ExpectedAwsAccountID: "123456789012",
+			ExpectedFolderID:     "folderID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAwsAccountID, gotFolderID, err := tfquicksight.FolderParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotAwsAccountID != testCase.ExpectedAwsAccountID {
+				t.Errorf("got AwsAccountID %s, expected %s", gotAwsAccountID, testCase.ExpectedAwsAccountID)
+			}
+
+			if gotFolderID != testCase.ExpectedFolderID {
+				t.Errorf("got FolderID %s, expected %s", gotFolderID, testCase.ExpectedFolderID)
+			}
+		})
+	}
+}
+
+func TestFolderMembershipParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectError          bool
+		ExpectedAwsAccountID string
+		ExpectedFolderID     string
+		ExpectedMemberType   string
+		ExpectedMemberID     string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "123456789012,folderID",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing member ID",
+			InputID:     "123456789012,folderID,DATASET,",
+			ExpectError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              tfquicksight.FolderMembershipCreateResourceID("123456789012", "folderID", "DATASET", "memberID"),
+			ExpectedAwsAccountID: "123456789012",
+			ExpectedFolderID:     "folderID",
+			ExpectedMemberType:   "DATASET",
+			ExpectedMemberID:     "memberID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAwsAccountID, gotFolderID, gotMemberType, gotMemberID, err := tfquicksight.FolderMembershipParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotAwsAccountID != testCase.ExpectedAwsAccountID {
+				t.Errorf("got AwsAccountID %s, expected %s", gotAwsAccountID, testCase.ExpectedAwsAccountID)
+			}
+
+			if gotFolderID != testCase.ExpectedFolderID {
+				t.Errorf("got FolderID %s, expected %s", gotFolderID, testCase.ExpectedFolderID)
+			}
+
+			if gotMemberType != testCase.ExpectedMemberType {
+				t.Errorf("got MemberType %s, expected %s", gotMemberType, testCase.ExpectedMemberType)
+			}
+
+			if gotMemberID != testCase.ExpectedMemberID {
+				t.Errorf("got MemberID %s, expected %s", gotMemberID, testCase.ExpectedMemberID)
+			}
+		})
+	}
+}
@@ -209,3 +209,64 @@ func ResourceSharePrincipalAssociationByShareARNPrincipal(conn *ram.RAM, resourc
 
 	return output.ResourceShareAssociations[0], nil
 }
+
+// ResourceSharePermissionsByShareARN returns the permissions associated with the resource share corresponding to the specified ARN.
+func ResourceSharePermissionsByShareARN(conn *ram.RAM, resourceShareARN string) ([]*ram.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+	var permissions []*ram.ResourceSharePermissionSummary
+
+	err := conn.ListResourceSharePermissionsPages(input, func(page *ram.ListResourceSharePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, permission := range page.Permissions {
+			if permission == nil {
+				continue
+			}
+
+			permissions = append(permissions, permission)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// ResourceShareResourcesByShareARN returns the resources associated with the resource share corresponding to the specified ARN.
+func ResourceShareResourcesByShareARN(conn *ram.RAM, resourceShareARN string) ([]*ram.Resource, error) {
+	input := &ram.ListResourcesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerSelf),
+		ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+	}
+	var resources []*ram.Resource
+
+	err := conn.ListResourcesPages(input, func(page *ram.ListResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resource := range page.Resources {
+			if resource == nil {
+				continue
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
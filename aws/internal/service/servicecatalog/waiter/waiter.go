@@ -1,6 +1,9 @@
 package waiter
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -524,12 +527,12 @@ func ProvisionedProductTerminated(conn *servicecatalog.ServiceCatalog, acceptLan
 	return err
 }
 
-func RecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeRecordOutput, error) {
+func RecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string, timeout time.Duration) (*servicecatalog.DescribeRecordOutput, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{StatusNotFound, StatusUnavailable, servicecatalog.ProvisionedProductStatusUnderChange, servicecatalog.ProvisionedProductStatusPlanInProgress},
+		Pending:                   []string{StatusNotFound, StatusUnavailable, servicecatalog.ProvisionedProductStatusUnderChange, servicecatalog.ProvisionedProductStatusPlanInProgress, servicecatalog.RecordStatusCreated, servicecatalog.RecordStatusInProgress, servicecatalog.RecordStatusInProgressInError},
 		Target:                    []string{servicecatalog.RecordStatusSucceeded, servicecatalog.StatusAvailable},
 		Refresh:                   RecordStatus(conn, acceptLanguage, id),
-		Timeout:                   RecordReadyTimeout,
+		Timeout:                   timeout,
 		ContinuousTargetOccurence: ContinuousTargetOccurrence,
 		NotFoundChecks:            NotFoundChecks,
 		MinTimeout:                MinTimeout,
@@ -538,6 +541,22 @@ func RecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string)
 	outputRaw, err := stateConf.WaitForState()
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
+		if output.RecordDetail != nil && aws.StringValue(output.RecordDetail.Status) == servicecatalog.RecordStatusFailed {
+			var recordErrors []string
+
+			for _, recordError := range output.RecordDetail.RecordErrors {
+				if recordError == nil {
+					continue
+				}
+
+				recordErrors = append(recordErrors, fmt.Sprintf("%s: %s", aws.StringValue(recordError.Code), aws.StringValue(recordError.Description)))
+			}
+
+			if len(recordErrors) > 0 {
+				tfresource.SetLastError(err, errors.New(strings.Join(recordErrors, "; ")))
+			}
+		}
+
 		return output, err
 	}
 
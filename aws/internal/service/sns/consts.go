@@ -3,3 +3,15 @@ package sns
 const (
 	FifoTopicNameSuffix = ".fifo"
 )
+
+const (
+	TopicTracingConfigActive      = "Active"
+	TopicTracingConfigPassThrough = "PassThrough"
+)
+
+func TopicTracingConfig_Values() []string {
+	return []string{
+		TopicTracingConfigActive,
+		TopicTracingConfigPassThrough,
+	}
+}
@@ -995,6 +995,8 @@ func Provider() *schema.Provider {
 			"aws_prometheus_workspace":                                resourceAwsPrometheusWorkspace(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
 			"aws_qldb_ledger":                                         resourceAwsQLDBLedger(),
+			"aws_quicksight_folder":                                   resourceAwsQuickSightFolder(),
+			"aws_quicksight_folder_membership":                        resourceAwsQuickSightFolderMembership(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
 			"aws_quicksight_group_membership":                         resourceAwsQuickSightGroupMembership(),
 			"aws_quicksight_user":                                     resourceAwsQuickSightUser(),
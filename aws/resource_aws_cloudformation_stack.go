@@ -103,6 +103,11 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"retain_resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"timeout_in_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -312,6 +317,11 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
+	// retain_resources is only used when deleting the stack.
+	if !d.HasChangesExcept("retain_resources") {
+		return resourceAwsCloudFormationStackRead(d, meta)
+	}
+
 	requestToken := resource.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		StackName:          aws.String(d.Id()),
@@ -398,7 +408,27 @@ func resourceAwsCloudFormationStackDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	_, err = waiter.StackDeleted(conn, d.Id(), requestToken, d.Timeout(schema.TimeoutDelete))
+	stack, err := waiter.StackDeleted(conn, d.Id(), requestToken, d.Timeout(schema.TimeoutDelete))
+
+	// Resources can only be retained once the stack is in DELETE_FAILED state.
+	if v, ok := d.GetOk("retain_resources"); ok && v.(*schema.Set).Len() > 0 && err != nil && stack != nil && aws.StringValue(stack.StackStatus) == cloudformation.StackStatusDeleteFailed {
+		log.Printf("[DEBUG] Retrying CloudFormation stack (%s) deletion, retaining resources", d.Id())
+
+		requestToken = resource.UniqueId()
+		input := &cloudformation.DeleteStackInput{
+			ClientRequestToken: aws.String(requestToken),
+			RetainResources:    expandStringSet(v.(*schema.Set)),
+			StackName:          aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Deleting CloudFormation stack %s", input)
+		if _, err := conn.DeleteStack(input); err != nil {
+			return fmt.Errorf("error deleting CloudFormation stack (%s) retaining resources: %w", d.Id(), err)
+		}
+
+		_, err = waiter.StackDeleted(conn, d.Id(), requestToken, d.Timeout(schema.TimeoutDelete))
+	}
+
 	if err != nil {
 		return fmt.Errorf("error waiting for CloudFormation Stack deletion: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -504,6 +505,72 @@ func TestAccAWSCloudFormationStack_onFailure(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormationStack_retainResources(t *testing.T) {
+	var stack1, stack2 cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackConfigRetainResources(rName, `["MyVPC"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack1),
+					resource.TestCheckResourceAttr(resourceName, "retain_resources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "retain_resources.*", "MyVPC"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_resources"},
+			},
+			{
+				Config: testAccAWSCloudFormationStackConfigRetainResources(rName, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack2),
+					testAccCheckCloudFormationStackNotRecreated(&stack1, &stack2),
+					resource.TestCheckResourceAttr(resourceName, "retain_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStack_retainResources_deleteFailed(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackConfigRetainResourcesBucket(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+				),
+			},
+			// The object left in the bucket makes the first stack deletion fail.
+			// The retained bucket is empty once the test is destroyed and is cleaned up by the aws_s3_bucket sweeper.
+			{
+				Config: testAccAWSCloudFormationStackConfigRetainResourcesBucketRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackRetainedBucketExists(rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -567,6 +634,18 @@ func testAccCheckCloudFormationStackNotRecreated(i, j *cloudformation.Stack) res
 	}
 }
 
+func testAccCheckCloudFormationStackRetainedBucketExists(bucketName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		if _, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(bucketName)}); err != nil {
+			return fmt.Errorf("retained S3 Bucket (%s) not found: %w", bucketName, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCloudFormationStackDisappears(stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).cfconn
@@ -1081,3 +1160,68 @@ resource "aws_cloudformation_stack" "test" {
 }
 `, rName)
 }
+
+func testAccAWSCloudFormationStackConfigRetainResources(rName, retainResources string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name             = %[1]q
+  retain_resources = %[2]s
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, retainResources)
+}
+
+func testAccAWSCloudFormationStackConfigRetainResourcesBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name             = %[1]q
+  retain_resources = ["MyBucket"]
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyBucket": {
+      "Type" : "AWS::S3::Bucket",
+      "Properties" : {
+        "BucketName" : %[1]q
+      }
+    }
+  }
+}
+STACK
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = %[1]q
+  key     = "test"
+  content = "test"
+
+  depends_on = [aws_cloudformation_stack.test]
+}
+`, rName)
+}
+
+func testAccAWSCloudFormationStackConfigRetainResourcesBucketRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_object" "test" {
+  bucket  = %[1]q
+  key     = "test"
+  content = "test"
+}
+`, rName)
+}
@@ -2122,9 +2122,10 @@ func flattenOnDemandSpecification(onDemandSpecification *emr.OnDemandProvisionin
 		return []interface{}{}
 	}
 	m := map[string]interface{}{
-		// The return value from api is wrong. it return "LOWEST_PRICE" instead of "lowest-price"
-		// "allocation_strategy": aws.StringValue(onDemandSpecification.AllocationStrategy),
-		"allocation_strategy": "lowest-price",
+		"allocation_strategy": emr.OnDemandProvisioningAllocationStrategyLowestPrice,
+	}
+	if onDemandSpecification.AllocationStrategy != nil {
+		m["allocation_strategy"] = normalizeEmrAllocationStrategy(aws.StringValue(onDemandSpecification.AllocationStrategy))
 	}
 	return []interface{}{m}
 }
@@ -2141,14 +2142,18 @@ func flattenSpotSpecification(spotSpecification *emr.SpotProvisioningSpecificati
 		m["block_duration_minutes"] = aws.Int64Value(spotSpecification.BlockDurationMinutes)
 	}
 	if spotSpecification.AllocationStrategy != nil {
-		// The return value from api is wrong. It return "CAPACITY_OPTIMIZED" instead of "capacity-optimized"
-		// m["allocation_strategy"] = aws.StringValue(spotSpecification.AllocationStrategy)
-		m["allocation_strategy"] = "capacity-optimized"
+		m["allocation_strategy"] = normalizeEmrAllocationStrategy(aws.StringValue(spotSpecification.AllocationStrategy))
 	}
 
 	return []interface{}{m}
 }
 
+// normalizeEmrAllocationStrategy converts the allocation strategy returned by the API
+// (e.g. "CAPACITY_OPTIMIZED") to the form accepted on input (e.g. "capacity-optimized").
+func normalizeEmrAllocationStrategy(v string) string {
+	return strings.ReplaceAll(strings.ToLower(v), "_", "-")
+}
+
 func expandEbsConfiguration(ebsConfigurations []interface{}) *emr.EbsConfiguration {
 	ebsConfig := &emr.EbsConfiguration{}
 	ebsConfigs := make([]*emr.EbsBlockDeviceConfig, 0)
@@ -77,6 +77,36 @@ func testSweepEmrClusters(region string) error {
 	return nil
 }
 
+func TestNormalizeEmrAllocationStrategy(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "LOWEST_PRICE",
+			Expected: "lowest-price",
+		},
+		{
+			Input:    "CAPACITY_OPTIMIZED",
+			Expected: "capacity-optimized",
+		},
+		{
+			Input:    "capacity-optimized",
+			Expected: "capacity-optimized",
+		},
+		{
+			Input:    "",
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := normalizeEmrAllocationStrategy(testCase.Input); got != testCase.Expected {
+			t.Errorf("normalizeEmrAllocationStrategy(%q) = %q, expected %q", testCase.Input, got, testCase.Expected)
+		}
+	}
+}
+
 func TestAccAWSEMRCluster_basic(t *testing.T) {
 	var cluster emr.Cluster
 
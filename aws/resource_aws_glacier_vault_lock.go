@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,18 +18,21 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsGlacierVaultLockCreate,
 		Read:   resourceAwsGlacierVaultLockRead,
-		// Allow ignore_deletion_error update
-		Update: schema.Noop,
+		Update: resourceAwsGlacierVaultLockUpdate,
 		Delete: resourceAwsGlacierVaultLockDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		// A completed lock is immutable, only an in progress lock can be completed in place
+		CustomizeDiff: customdiff.ForceNewIfChange("complete_lock", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(bool) && !new.(bool)
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
@@ -55,38 +60,10 @@ func resourceAwsGlacierVaultLockCreate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).glacierconn
 	vaultName := d.Get("vault_name").(string)
 
-	input := &glacier.InitiateVaultLockInput{
-		AccountId: aws.String("-"),
-		Policy: &glacier.VaultLockPolicy{
-			Policy: aws.String(d.Get("policy").(string)),
-		},
-		VaultName: aws.String(vaultName),
-	}
-
-	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
-	output, err := conn.InitiateVaultLock(input)
-	if err != nil {
-		return fmt.Errorf("error initiating Glacier Vault Lock: %s", err)
-	}
-
 	d.SetId(vaultName)
 
-	if !d.Get("complete_lock").(bool) {
-		return resourceAwsGlacierVaultLockRead(d, meta)
-	}
-
-	completeLockInput := &glacier.CompleteVaultLockInput{
-		LockId:    output.LockId,
-		VaultName: aws.String(vaultName),
-	}
-
-	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, completeLockInput)
-	if _, err := conn.CompleteVaultLock(completeLockInput); err != nil {
-		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %s", vaultName, err)
-	}
-
-	if err := waitForGlacierVaultLockCompletion(conn, vaultName); err != nil {
-		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
+	if err := glacierVaultLockInitiate(conn, vaultName, d.Get("policy").(string), d.Get("complete_lock").(bool)); err != nil {
+		return err
 	}
 
 	return resourceAwsGlacierVaultLockRead(d, meta)
@@ -126,6 +103,31 @@ func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceAwsGlacierVaultLockUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glacierconn
+
+	// The lock ID of an in progress lock is only returned when it is initiated,
+	// so completing it requires aborting and initiating the lock again.
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) {
+		input := &glacier.AbortVaultLockInput{
+			VaultName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s): %s", d.Id(), input)
+		_, err := conn.AbortVaultLock(input)
+
+		if err != nil && !isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("error aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+		}
+
+		if err := glacierVaultLockInitiate(conn, d.Id(), d.Get("policy").(string), true); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlacierVaultLockRead(d, meta)
+}
+
 func resourceAwsGlacierVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glacierconn
 
@@ -147,6 +149,42 @@ func resourceAwsGlacierVaultLockDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func glacierVaultLockInitiate(conn *glacier.Glacier, vaultName, policy string, completeLock bool) error {
+	input := &glacier.InitiateVaultLockInput{
+		AccountId: aws.String("-"),
+		Policy: &glacier.VaultLockPolicy{
+			Policy: aws.String(policy),
+		},
+		VaultName: aws.String(vaultName),
+	}
+
+	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
+	output, err := conn.InitiateVaultLock(input)
+	if err != nil {
+		return fmt.Errorf("error initiating Glacier Vault Lock: %s", err)
+	}
+
+	if !completeLock {
+		return nil
+	}
+
+	completeLockInput := &glacier.CompleteVaultLockInput{
+		LockId:    output.LockId,
+		VaultName: aws.String(vaultName),
+	}
+
+	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, completeLockInput)
+	if _, err := conn.CompleteVaultLock(completeLockInput); err != nil {
+		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %s", vaultName, err)
+	}
+
+	if err := waitForGlacierVaultLockCompletion(conn, vaultName); err != nil {
+		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", vaultName, err)
+	}
+
+	return nil
+}
+
 func glacierVaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &glacier.GetVaultLockInput{
@@ -43,6 +43,47 @@ func TestAccAWSGlacierVaultLock_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSGlacierVaultLock_CompleteLock_InPlace(t *testing.T) {
+	var vaultLock1, vaultLock2 glacier.GetVaultLockOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, glacier.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlacierVaultLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlacierVaultLockConfigCompleteLockPreventDestroy(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName, &vaultLock1),
+					testAccCheckGlacierVaultLockState(&vaultLock1, "InProgress"),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "false"),
+				),
+			},
+			// prevent_destroy fails the apply if completing the lock requires replacement
+			{
+				Config: testAccGlacierVaultLockConfigCompleteLockPreventDestroy(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName, &vaultLock2),
+					testAccCheckGlacierVaultLockState(&vaultLock2, "Locked"),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "true"),
+				),
+			},
+			{
+				Config: testAccGlacierVaultLockConfigCompleteLock(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlacierVaultLockExists(resourceName, &vaultLock2),
+					testAccCheckGlacierVaultLockState(&vaultLock2, "Locked"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSGlacierVaultLock_CompleteLock(t *testing.T) {
 	var vaultLock1 glacier.GetVaultLockOutput
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -107,6 +148,16 @@ func testAccCheckGlacierVaultLockExists(resourceName string, getVaultLockOutput
 	}
 }
 
+func testAccCheckGlacierVaultLockState(getVaultLockOutput *glacier.GetVaultLockOutput, expectedState string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if state := aws.StringValue(getVaultLockOutput.State); state != expectedState {
+			return fmt.Errorf("Glacier Vault Lock state = %s, expected %s", state, expectedState)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckGlacierVaultLockDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).glacierconn
 
@@ -136,10 +187,10 @@ func testAccCheckGlacierVaultLockDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccGlacierVaultLockConfigCompleteLock(rName string, completeLock bool) string {
+func testAccGlacierVaultLockConfigBase(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_glacier_vault" "test" {
-  name = %q
+  name = %[1]q
 }
 
 data "aws_caller_identity" "current" {}
@@ -164,12 +215,35 @@ data "aws_iam_policy_document" "test" {
     }
   }
 }
+`, rName)
+}
+
+func testAccGlacierVaultLockConfigCompleteLock(rName string, completeLock bool) string {
+	return composeConfig(
+		testAccGlacierVaultLockConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glacier_vault_lock" "test" {
+  complete_lock         = %[1]t
+  ignore_deletion_error = %[1]t
+  policy                = data.aws_iam_policy_document.test.json
+  vault_name            = aws_glacier_vault.test.name
+}
+`, completeLock))
+}
 
+func testAccGlacierVaultLockConfigCompleteLockPreventDestroy(rName string, completeLock bool) string {
+	return composeConfig(
+		testAccGlacierVaultLockConfigBase(rName),
+		fmt.Sprintf(`
 resource "aws_glacier_vault_lock" "test" {
-  complete_lock         = %t
-  ignore_deletion_error = %t
+  complete_lock         = %[1]t
+  ignore_deletion_error = %[1]t
   policy                = data.aws_iam_policy_document.test.json
   vault_name            = aws_glacier_vault.test.name
+
+  lifecycle {
+    prevent_destroy = true
+  }
 }
-`, rName, completeLock, completeLock)
+`, completeLock))
 }
@@ -124,12 +124,14 @@ func resourceAwsIotThingTypeRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading IoT Thing Type: %s", params)
 	out, err := conn.DescribeThingType(params)
 
+	if !d.IsNewResource() && isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] IoT Thing Type %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
-		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
-			log.Printf("[WARN] IoT Thing Type %q not found, removing from state", d.Id())
-			d.SetId("")
-		}
-		return err
+		return fmt.Errorf("error reading IoT Thing Type (%s): %w", d.Id(), err)
 	}
 
 	if out.ThingTypeMetadata != nil {
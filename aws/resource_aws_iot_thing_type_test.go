@@ -92,6 +92,27 @@ func TestAccAWSIotThingType_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSIotThingType_disappears(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_iot_thing_type.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, iot.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingTypeConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotThingType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSIotThingType_full(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -107,6 +128,9 @@ func TestAccAWSIotThingType_full(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_iot_thing_type.foo", "arn"),
 					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "properties.0.description", "MyDescription"),
 					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "properties.0.searchable_attributes.#", "3"),
+					resource.TestCheckTypeSetElemAttr("aws_iot_thing_type.foo", "properties.0.searchable_attributes.*", "foo"),
+					resource.TestCheckTypeSetElemAttr("aws_iot_thing_type.foo", "properties.0.searchable_attributes.*", "bar"),
+					resource.TestCheckTypeSetElemAttr("aws_iot_thing_type.foo", "properties.0.searchable_attributes.*", "baz"),
 					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "deprecated", "true"),
 				),
 			},
@@ -119,6 +143,7 @@ func TestAccAWSIotThingType_full(t *testing.T) {
 				Config: testAccAWSIotThingTypeConfig_fullUpdated(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "deprecated", "false"),
+					resource.TestCheckResourceAttr("aws_iot_thing_type.foo", "properties.0.searchable_attributes.#", "3"),
 				),
 			},
 		},
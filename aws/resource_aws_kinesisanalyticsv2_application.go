@@ -34,6 +34,8 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 				// An existing input configuration cannot be deleted.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			resourceAwsKinesisAnalyticsV2ApplicationCustomizeDiffApplicationCodeConfiguration,
+			resourceAwsKinesisAnalyticsV2ApplicationCustomizeDiffApplicationMode,
 		),
 
 		Importer: &schema.ResourceImporter{
@@ -50,7 +52,7 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"application_code_configuration": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -824,10 +826,104 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 							},
 							ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
 						},
+
+						"zeppelin_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"glue_data_catalog_configuration": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"database_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"deploy_as_application_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"base_path": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"log_level": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.LogLevel_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+							ConflictsWith: []string{
+								"application_configuration.0.flink_application_configuration",
+								"application_configuration.0.sql_application_configuration",
+							},
+						},
 					},
 				},
 			},
 
+			"application_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ApplicationMode_Values(), false),
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -935,6 +1031,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta
 		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
 	}
 
+	if v, ok := d.GetOk("application_mode"); ok {
+		input.ApplicationMode = aws.String(v.(string))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = tags.IgnoreAws().Kinesisanalyticsv2Tags()
 	}
@@ -982,6 +1082,7 @@ func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta i
 	}
 
 	arn := aws.StringValue(application.ApplicationARN)
+	d.Set("application_mode", application.ApplicationMode)
 	d.Set("arn", arn)
 	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
 	d.Set("description", application.ApplicationDescription)
@@ -1059,6 +1160,12 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 				updateApplication = true
 			}
 
+			if d.HasChange("application_configuration.0.zeppelin_application_configuration") {
+				applicationConfigurationUpdate.ZeppelinApplicationConfigurationUpdate = expandKinesisAnalyticsV2ZeppelinApplicationConfigurationUpdate(d.Get("application_configuration.0.zeppelin_application_configuration").([]interface{}))
+
+				updateApplication = true
+			}
+
 			if d.HasChange("application_configuration.0.sql_application_configuration") {
 				sqlApplicationConfigurationUpdate := &kinesisanalyticsv2.SqlApplicationConfigurationUpdate{}
 
@@ -1555,6 +1662,39 @@ func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceAwsKinesisAnalyticsV2ApplicationCustomizeDiffApplicationCodeConfiguration requires application code for SQL-based and Flink-based applications.
+// Studio notebook (Zeppelin-based) applications have no application code.
+func resourceAwsKinesisAnalyticsV2ApplicationCustomizeDiffApplicationCodeConfiguration(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if strings.HasPrefix(diff.Get("runtime_environment").(string), "ZEPPELIN-") {
+		return nil
+	}
+
+	if v, ok := diff.Get("application_configuration").([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	if v, ok := diff.Get("application_configuration.0.application_code_configuration").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return nil
+	}
+
+	return fmt.Errorf("application_configuration.0.application_code_configuration is required for %s applications", diff.Get("runtime_environment").(string))
+}
+
+// resourceAwsKinesisAnalyticsV2ApplicationCustomizeDiffApplicationMode requires Studio notebook (Zeppelin-based) applications to be created in INTERACTIVE mode.
+func resourceAwsKinesisAnalyticsV2ApplicationCustomizeDiffApplicationMode(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	runtimeEnvironment := diff.Get("runtime_environment").(string)
+
+	if !strings.HasPrefix(runtimeEnvironment, "ZEPPELIN-") {
+		return nil
+	}
+
+	if v := diff.Get("application_mode").(string); v != kinesisanalyticsv2.ApplicationModeInteractive {
+		return fmt.Errorf("application_mode must be %s for %s applications", kinesisanalyticsv2.ApplicationModeInteractive, runtimeEnvironment)
+	}
+
+	return nil
+}
+
 func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, input *kinesisanalyticsv2.StartApplicationInput) error {
 	applicationName := aws.StringValue(input.ApplicationName)
 
@@ -1799,6 +1939,10 @@ func expandKinesisAnalyticsV2ApplicationConfiguration(vApplicationConfiguration
 		applicationConfiguration.VpcConfigurations = []*kinesisanalyticsv2.VpcConfiguration{expandKinesisAnalyticsV2VpcConfiguration(vVpcConfiguration)}
 	}
 
+	if vZeppelinApplicationConfiguration, ok := mApplicationConfiguration["zeppelin_application_configuration"].([]interface{}); ok && len(vZeppelinApplicationConfiguration) > 0 && vZeppelinApplicationConfiguration[0] != nil {
+		applicationConfiguration.ZeppelinApplicationConfiguration = expandKinesisAnalyticsV2ZeppelinApplicationConfiguration(vZeppelinApplicationConfiguration)
+	}
+
 	return applicationConfiguration
 }
 
@@ -2482,6 +2626,140 @@ func expandKinesisAnalyticsV2VpcConfigurationUpdate(vVpcConfiguration []interfac
 	return vpcConfigurationUpdate
 }
 
+func expandKinesisAnalyticsV2ZeppelinApplicationConfiguration(vZeppelinApplicationConfiguration []interface{}) *kinesisanalyticsv2.ZeppelinApplicationConfiguration {
+	if len(vZeppelinApplicationConfiguration) == 0 || vZeppelinApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	zeppelinApplicationConfiguration := &kinesisanalyticsv2.ZeppelinApplicationConfiguration{}
+
+	mZeppelinApplicationConfiguration := vZeppelinApplicationConfiguration[0].(map[string]interface{})
+
+	if vCatalogConfiguration, ok := mZeppelinApplicationConfiguration["catalog_configuration"].([]interface{}); ok && len(vCatalogConfiguration) > 0 && vCatalogConfiguration[0] != nil {
+		catalogConfiguration := &kinesisanalyticsv2.CatalogConfiguration{}
+
+		mCatalogConfiguration := vCatalogConfiguration[0].(map[string]interface{})
+
+		if vGlueDataCatalogConfiguration, ok := mCatalogConfiguration["glue_data_catalog_configuration"].([]interface{}); ok && len(vGlueDataCatalogConfiguration) > 0 && vGlueDataCatalogConfiguration[0] != nil {
+			glueDataCatalogConfiguration := &kinesisanalyticsv2.GlueDataCatalogConfiguration{}
+
+			mGlueDataCatalogConfiguration := vGlueDataCatalogConfiguration[0].(map[string]interface{})
+
+			if vDatabaseArn, ok := mGlueDataCatalogConfiguration["database_arn"].(string); ok && vDatabaseArn != "" {
+				glueDataCatalogConfiguration.DatabaseARN = aws.String(vDatabaseArn)
+			}
+
+			catalogConfiguration.GlueDataCatalogConfiguration = glueDataCatalogConfiguration
+		}
+
+		zeppelinApplicationConfiguration.CatalogConfiguration = catalogConfiguration
+	}
+
+	if vDeployAsApplicationConfiguration, ok := mZeppelinApplicationConfiguration["deploy_as_application_configuration"].([]interface{}); ok && len(vDeployAsApplicationConfiguration) > 0 && vDeployAsApplicationConfiguration[0] != nil {
+		deployAsApplicationConfiguration := &kinesisanalyticsv2.DeployAsApplicationConfiguration{}
+
+		mDeployAsApplicationConfiguration := vDeployAsApplicationConfiguration[0].(map[string]interface{})
+
+		if vS3ContentLocation, ok := mDeployAsApplicationConfiguration["s3_content_location"].([]interface{}); ok && len(vS3ContentLocation) > 0 && vS3ContentLocation[0] != nil {
+			s3ContentLocation := &kinesisanalyticsv2.S3ContentBaseLocation{}
+
+			mS3ContentLocation := vS3ContentLocation[0].(map[string]interface{})
+
+			if vBasePath, ok := mS3ContentLocation["base_path"].(string); ok && vBasePath != "" {
+				s3ContentLocation.BasePath = aws.String(vBasePath)
+			}
+			if vBucketArn, ok := mS3ContentLocation["bucket_arn"].(string); ok && vBucketArn != "" {
+				s3ContentLocation.BucketARN = aws.String(vBucketArn)
+			}
+
+			deployAsApplicationConfiguration.S3ContentLocation = s3ContentLocation
+		}
+
+		zeppelinApplicationConfiguration.DeployAsApplicationConfiguration = deployAsApplicationConfiguration
+	}
+
+	if vMonitoringConfiguration, ok := mZeppelinApplicationConfiguration["monitoring_configuration"].([]interface{}); ok && len(vMonitoringConfiguration) > 0 && vMonitoringConfiguration[0] != nil {
+		monitoringConfiguration := &kinesisanalyticsv2.ZeppelinMonitoringConfiguration{}
+
+		mMonitoringConfiguration := vMonitoringConfiguration[0].(map[string]interface{})
+
+		if vLogLevel, ok := mMonitoringConfiguration["log_level"].(string); ok && vLogLevel != "" {
+			monitoringConfiguration.LogLevel = aws.String(vLogLevel)
+		}
+
+		zeppelinApplicationConfiguration.MonitoringConfiguration = monitoringConfiguration
+	}
+
+	return zeppelinApplicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ZeppelinApplicationConfigurationUpdate(vZeppelinApplicationConfiguration []interface{}) *kinesisanalyticsv2.ZeppelinApplicationConfigurationUpdate {
+	if len(vZeppelinApplicationConfiguration) == 0 || vZeppelinApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	zeppelinApplicationConfigurationUpdate := &kinesisanalyticsv2.ZeppelinApplicationConfigurationUpdate{}
+
+	mZeppelinApplicationConfiguration := vZeppelinApplicationConfiguration[0].(map[string]interface{})
+
+	if vCatalogConfiguration, ok := mZeppelinApplicationConfiguration["catalog_configuration"].([]interface{}); ok && len(vCatalogConfiguration) > 0 && vCatalogConfiguration[0] != nil {
+		catalogConfigurationUpdate := &kinesisanalyticsv2.CatalogConfigurationUpdate{}
+
+		mCatalogConfiguration := vCatalogConfiguration[0].(map[string]interface{})
+
+		if vGlueDataCatalogConfiguration, ok := mCatalogConfiguration["glue_data_catalog_configuration"].([]interface{}); ok && len(vGlueDataCatalogConfiguration) > 0 && vGlueDataCatalogConfiguration[0] != nil {
+			glueDataCatalogConfigurationUpdate := &kinesisanalyticsv2.GlueDataCatalogConfigurationUpdate{}
+
+			mGlueDataCatalogConfiguration := vGlueDataCatalogConfiguration[0].(map[string]interface{})
+
+			if vDatabaseArn, ok := mGlueDataCatalogConfiguration["database_arn"].(string); ok && vDatabaseArn != "" {
+				glueDataCatalogConfigurationUpdate.DatabaseARNUpdate = aws.String(vDatabaseArn)
+			}
+
+			catalogConfigurationUpdate.GlueDataCatalogConfigurationUpdate = glueDataCatalogConfigurationUpdate
+		}
+
+		zeppelinApplicationConfigurationUpdate.CatalogConfigurationUpdate = catalogConfigurationUpdate
+	}
+
+	if vDeployAsApplicationConfiguration, ok := mZeppelinApplicationConfiguration["deploy_as_application_configuration"].([]interface{}); ok && len(vDeployAsApplicationConfiguration) > 0 && vDeployAsApplicationConfiguration[0] != nil {
+		deployAsApplicationConfigurationUpdate := &kinesisanalyticsv2.DeployAsApplicationConfigurationUpdate{}
+
+		mDeployAsApplicationConfiguration := vDeployAsApplicationConfiguration[0].(map[string]interface{})
+
+		if vS3ContentLocation, ok := mDeployAsApplicationConfiguration["s3_content_location"].([]interface{}); ok && len(vS3ContentLocation) > 0 && vS3ContentLocation[0] != nil {
+			s3ContentLocationUpdate := &kinesisanalyticsv2.S3ContentBaseLocationUpdate{}
+
+			mS3ContentLocation := vS3ContentLocation[0].(map[string]interface{})
+
+			if vBasePath, ok := mS3ContentLocation["base_path"].(string); ok && vBasePath != "" {
+				s3ContentLocationUpdate.BasePathUpdate = aws.String(vBasePath)
+			}
+			if vBucketArn, ok := mS3ContentLocation["bucket_arn"].(string); ok && vBucketArn != "" {
+				s3ContentLocationUpdate.BucketARNUpdate = aws.String(vBucketArn)
+			}
+
+			deployAsApplicationConfigurationUpdate.S3ContentLocationUpdate = s3ContentLocationUpdate
+		}
+
+		zeppelinApplicationConfigurationUpdate.DeployAsApplicationConfigurationUpdate = deployAsApplicationConfigurationUpdate
+	}
+
+	if vMonitoringConfiguration, ok := mZeppelinApplicationConfiguration["monitoring_configuration"].([]interface{}); ok && len(vMonitoringConfiguration) > 0 && vMonitoringConfiguration[0] != nil {
+		monitoringConfigurationUpdate := &kinesisanalyticsv2.ZeppelinMonitoringConfigurationUpdate{}
+
+		mMonitoringConfiguration := vMonitoringConfiguration[0].(map[string]interface{})
+
+		if vLogLevel, ok := mMonitoringConfiguration["log_level"].(string); ok && vLogLevel != "" {
+			monitoringConfigurationUpdate.LogLevelUpdate = aws.String(vLogLevel)
+		}
+
+		zeppelinApplicationConfigurationUpdate.MonitoringConfigurationUpdate = monitoringConfigurationUpdate
+	}
+
+	return zeppelinApplicationConfigurationUpdate
+}
+
 func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationConfigurationDescription *kinesisanalyticsv2.ApplicationConfigurationDescription) []interface{} {
 	if applicationConfigurationDescription == nil {
 		return []interface{}{}
@@ -2759,6 +3037,49 @@ func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationCon
 		mApplicationConfiguration["vpc_configuration"] = []interface{}{mVpcConfiguration}
 	}
 
+	if zeppelinApplicationConfigurationDescription := applicationConfigurationDescription.ZeppelinApplicationConfigurationDescription; zeppelinApplicationConfigurationDescription != nil {
+		mZeppelinApplicationConfiguration := map[string]interface{}{}
+
+		if catalogConfigurationDescription := zeppelinApplicationConfigurationDescription.CatalogConfigurationDescription; catalogConfigurationDescription != nil {
+			mCatalogConfiguration := map[string]interface{}{}
+
+			if glueDataCatalogConfigurationDescription := catalogConfigurationDescription.GlueDataCatalogConfigurationDescription; glueDataCatalogConfigurationDescription != nil {
+				mGlueDataCatalogConfiguration := map[string]interface{}{
+					"database_arn": aws.StringValue(glueDataCatalogConfigurationDescription.DatabaseARN),
+				}
+
+				mCatalogConfiguration["glue_data_catalog_configuration"] = []interface{}{mGlueDataCatalogConfiguration}
+			}
+
+			mZeppelinApplicationConfiguration["catalog_configuration"] = []interface{}{mCatalogConfiguration}
+		}
+
+		if deployAsApplicationConfigurationDescription := zeppelinApplicationConfigurationDescription.DeployAsApplicationConfigurationDescription; deployAsApplicationConfigurationDescription != nil {
+			mDeployAsApplicationConfiguration := map[string]interface{}{}
+
+			if s3ContentLocationDescription := deployAsApplicationConfigurationDescription.S3ContentLocationDescription; s3ContentLocationDescription != nil {
+				mS3ContentLocation := map[string]interface{}{
+					"base_path":  aws.StringValue(s3ContentLocationDescription.BasePath),
+					"bucket_arn": aws.StringValue(s3ContentLocationDescription.BucketARN),
+				}
+
+				mDeployAsApplicationConfiguration["s3_content_location"] = []interface{}{mS3ContentLocation}
+			}
+
+			mZeppelinApplicationConfiguration["deploy_as_application_configuration"] = []interface{}{mDeployAsApplicationConfiguration}
+		}
+
+		if monitoringConfigurationDescription := zeppelinApplicationConfigurationDescription.MonitoringConfigurationDescription; monitoringConfigurationDescription != nil {
+			mMonitoringConfiguration := map[string]interface{}{
+				"log_level": aws.StringValue(monitoringConfigurationDescription.LogLevel),
+			}
+
+			mZeppelinApplicationConfiguration["monitoring_configuration"] = []interface{}{mMonitoringConfiguration}
+		}
+
+		mApplicationConfiguration["zeppelin_application_configuration"] = []interface{}{mZeppelinApplicationConfiguration}
+	}
+
 	return []interface{}{mApplicationConfiguration}
 }
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
@@ -3987,6 +3988,103 @@ func TestAccAWSKinesisAnalyticsV2Application_SQLApplicationConfiguration_VPCConf
 	})
 }
 
+func TestAccAWSKinesisAnalyticsV2Application_ApplicationCodeConfiguration_Required(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisAnalyticsV2(t) },
+		ErrorCheck:   testAccErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisAnalyticsV2ApplicationConfigApplicationCodeConfigurationNotSpecified(rName),
+				ExpectError: regexp.MustCompile(`application_code_configuration is required for FLINK-1_8 applications`),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_ZeppelinApplicationConfiguration_Update(t *testing.T) {
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	databaseResourceName := "aws_glue_catalog_database.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisAnalyticsV2(t) },
+		ErrorCheck:   testAccErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationConfiguration(rName, "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_mode", "INTERACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.catalog_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.catalog_configuration.0.glue_data_catalog_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_configuration.0.zeppelin_application_configuration.0.catalog_configuration.0.glue_data_catalog_configuration.0.database_arn", databaseResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.deploy_as_application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.deploy_as_application_configuration.0.s3_content_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.deploy_as_application_configuration.0.s3_content_location.0.base_path", "notebooks"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_configuration.0.zeppelin_application_configuration.0.deploy_as_application_configuration.0.s3_content_location.0.bucket_arn", bucketResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.monitoring_configuration.0.log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_environment", "ZEPPELIN-FLINK-1_0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationConfiguration(rName, "DEBUG"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_mode", "INTERACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.monitoring_configuration.0.log_level", "DEBUG"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "2"),
+				),
+			},
+			// Removed blocks keep their current settings
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationConfigurationRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.catalog_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.deploy_as_application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.zeppelin_application_configuration.0.monitoring_configuration.0.log_level", "DEBUG"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_ZeppelinApplicationConfiguration_ApplicationMode(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisAnalyticsV2(t) },
+		ErrorCheck:   testAccErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationMode(rName, "STREAMING"),
+				ExpectError: regexp.MustCompile(`application_mode must be INTERACTIVE for ZEPPELIN-FLINK-1_0 applications`),
+			},
+		},
+	})
+}
+
 func testAccCheckKinesisAnalyticsV2ApplicationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
 
@@ -4283,6 +4381,30 @@ resource "aws_kinesisanalyticsv2_application" "test" {
 `, rName, textContent))
 }
 
+func testAccKinesisAnalyticsV2ApplicationConfigApplicationCodeConfigurationNotSpecified(rName string) string {
+	return composeConfig(
+		testAccKinesisAnalyticsV2ApplicationConfigBaseServiceExecutionIamRole(rName),
+		fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_8"
+  service_execution_role = aws_iam_role.test[0].arn
+
+  application_configuration {
+    environment_properties {
+      property_group {
+        property_group_id = "PROPERTY-GROUP-1"
+
+        property_map = {
+          Key1 = "Value1"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptions(rName string, streamIndex int) string {
 	return composeConfig(
 		testAccKinesisAnalyticsV2ApplicationConfigBaseServiceExecutionIamRole(rName),
@@ -5686,3 +5808,87 @@ resource "aws_kinesisanalyticsv2_application" "test" {
 }
 `, rName))
 }
+
+func testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationConfiguration(rName, logLevel string) string {
+	return composeConfig(
+		testAccKinesisAnalyticsV2ApplicationConfigBaseServiceExecutionIamRole(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  application_mode       = "INTERACTIVE"
+  runtime_environment    = "ZEPPELIN-FLINK-1_0"
+  service_execution_role = aws_iam_role.test[0].arn
+
+  application_configuration {
+    zeppelin_application_configuration {
+      catalog_configuration {
+        glue_data_catalog_configuration {
+          database_arn = aws_glue_catalog_database.test.arn
+        }
+      }
+
+      deploy_as_application_configuration {
+        s3_content_location {
+          base_path  = "notebooks"
+          bucket_arn = aws_s3_bucket.test.arn
+        }
+      }
+
+      monitoring_configuration {
+        log_level = %[2]q
+      }
+    }
+  }
+}
+`, rName, logLevel))
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationConfigurationRemoved(rName string) string {
+	return composeConfig(
+		testAccKinesisAnalyticsV2ApplicationConfigBaseServiceExecutionIamRole(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  application_mode       = "INTERACTIVE"
+  runtime_environment    = "ZEPPELIN-FLINK-1_0"
+  service_execution_role = aws_iam_role.test[0].arn
+
+  application_configuration {
+    zeppelin_application_configuration {
+      monitoring_configuration {
+        log_level = "DEBUG"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigZeppelinApplicationMode(rName, applicationMode string) string {
+	return composeConfig(
+		testAccKinesisAnalyticsV2ApplicationConfigBaseServiceExecutionIamRole(rName),
+		fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  application_mode       = %[2]q
+  runtime_environment    = "ZEPPELIN-FLINK-1_0"
+  service_execution_role = aws_iam_role.test[0].arn
+}
+`, rName, applicationMode))
+}
@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -89,7 +91,10 @@ func resourceAwsMediaConvertQueue() *schema.Resource {
 			"tags_all": tagsSchemaComputed(),
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsMediaConvertQueueCustomizeDiff,
+		),
 	}
 }
 
@@ -197,7 +202,8 @@ func resourceAwsMediaConvertQueueUpdate(d *schema.ResourceData, meta interface{}
 			updateOpts.Description = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk("reservation_plan_settings"); ok {
+		// Only send the reservation plan when it changes as each update is a new purchase.
+		if v, ok := d.GetOk("reservation_plan_settings"); ok && d.HasChange("reservation_plan_settings") {
 			reservationPlanSettings := v.([]interface{})[0].(map[string]interface{})
 			updateOpts.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(reservationPlanSettings)
 		}
@@ -281,3 +287,18 @@ func getAwsMediaConvertAccountClient(awsClient *AWSClient) (*mediaconvert.MediaC
 
 	return conn, nil
 }
+
+func resourceAwsMediaConvertQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("reservation_plan_settings.0.reserved_slots") {
+		return nil
+	}
+
+	// An existing reservation can be extended but never reduced; fewer slots need a new reservation.
+	o, n := diff.GetChange("reservation_plan_settings.0.reserved_slots")
+
+	if oldSlots, newSlots := o.(int), n.(int); oldSlots > 0 && newSlots < oldSlots {
+		return fmt.Errorf("reservation_plan_settings.0.reserved_slots cannot be decreased from %d to %d: AWS only allows adding reserved slots to an existing reservation", oldSlots, newSlots)
+	}
+
+	return nil
+}
@@ -74,9 +74,13 @@ func TestAccAWSMediaConvertQueue_ReservationPlanSettings(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.commitment", mediaconvert.CommitmentOneYear),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.renewal_type", mediaconvert.RenewalTypeExpire),
-					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.reserved_slots", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.reserved_slots", "2"),
 				),
 			},
+			{
+				Config:      testAccMediaConvertQueueConfig_ReservedQueue(rName, mediaconvert.CommitmentOneYear, mediaconvert.RenewalTypeExpire, 1),
+				ExpectError: regexp.MustCompile(`reserved_slots cannot be decreased from 2 to 1`),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tforganizations "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations"
 )
 
 const organizationsPolicyTypeStatusDisabled = "DISABLED"
@@ -152,7 +153,7 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+					ValidateFunc: validation.StringInSlice(tforganizations.PolicyType_Values(), false),
 				},
 			},
 			"feature_set": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tforganizations "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations"
 )
 
 func resourceAwsOrganizationsPolicy() *schema.Resource {
@@ -49,7 +50,7 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      organizations.PolicyTypeServiceControlPolicy,
-				ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+				ValidateFunc: validation.StringInSlice(tforganizations.PolicyType_Values(), false),
 			},
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
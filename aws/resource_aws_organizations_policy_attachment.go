@@ -18,10 +18,20 @@ func resourceAwsOrganizationsPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsOrganizationsPolicyAttachmentRead,
 		Delete: resourceAwsOrganizationsPolicyAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("enable_policy_type", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
+			"enable_policy_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			"policy_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -67,8 +77,27 @@ func resourceAwsOrganizationsPolicyAttachmentCreate(d *schema.ResourceData, meta
 		_, err = conn.AttachPolicy(input)
 	}
 
+	// The policy type must be enabled in the organization root before policies of that type can be attached.
+	if isAWSErr(err, organizations.ErrCodePolicyTypeNotEnabledException, "") {
+		policyType, typeErr := organizationsPolicyAttachmentPolicyType(conn, policyID)
+
+		if typeErr != nil {
+			return fmt.Errorf("error creating Organizations Policy Attachment: %w", typeErr)
+		}
+
+		if !d.Get("enable_policy_type").(bool) {
+			return fmt.Errorf("error creating Organizations Policy Attachment: policy type (%s) is not enabled in the Organization Root, set enable_policy_type to true or add it to the aws_organizations_organization resource's enabled_policy_types argument: %w", policyType, err)
+		}
+
+		if err := enableOrganizationsPolicyAttachmentPolicyType(conn, policyType); err != nil {
+			return fmt.Errorf("error creating Organizations Policy Attachment: %w", err)
+		}
+
+		_, err = conn.AttachPolicy(input)
+	}
+
 	if err != nil {
-		return fmt.Errorf("error creating Organizations Policy Attachment: %s", err)
+		return fmt.Errorf("error creating Organizations Policy Attachment: %w", err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", targetID, policyID))
@@ -148,6 +177,46 @@ func resourceAwsOrganizationsPolicyAttachmentDelete(d *schema.ResourceData, meta
 	return nil
 }
 
+func organizationsPolicyAttachmentPolicyType(conn *organizations.Organizations, policyID string) (string, error) {
+	output, err := conn.DescribePolicy(&organizations.DescribePolicyInput{
+		PolicyId: aws.String(policyID),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error reading Organizations Policy (%s): %w", policyID, err)
+	}
+
+	if output == nil || output.Policy == nil || output.Policy.PolicySummary == nil {
+		return "", fmt.Errorf("error reading Organizations Policy (%s): empty result", policyID)
+	}
+
+	return aws.StringValue(output.Policy.PolicySummary.Type), nil
+}
+
+func enableOrganizationsPolicyAttachmentPolicyType(conn *organizations.Organizations, policyType string) error {
+	defaultRoot, err := getOrganizationDefaultRoot(conn)
+
+	if err != nil {
+		return fmt.Errorf("error getting Organization default root: %w", err)
+	}
+
+	input := &organizations.EnablePolicyTypeInput{
+		PolicyType: aws.String(policyType),
+		RootId:     defaultRoot.Id,
+	}
+
+	log.Printf("[DEBUG] Enabling Policy Type in Organization: %s", input)
+	if _, err := conn.EnablePolicyType(input); err != nil {
+		return fmt.Errorf("policy type (%s) is not enabled in Organization Root (%s) and could not be enabled, enable it using the aws_organizations_organization resource's enabled_policy_types argument: %w", policyType, aws.StringValue(defaultRoot.Id), err)
+	}
+
+	if err := waitForOrganizationDefaultRootPolicyTypeEnable(conn, policyType); err != nil {
+		return fmt.Errorf("error waiting for policy type (%s) enabling in Organization Root (%s): %w", policyType, aws.StringValue(defaultRoot.Id), err)
+	}
+
+	return nil
+}
+
 func decodeAwsOrganizationsPolicyAttachmentID(id string) (string, string, error) {
 	idParts := strings.Split(id, ":")
 	if len(idParts) != 2 {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -111,6 +112,35 @@ func testAccAwsOrganizationsPolicyAttachment_Root(t *testing.T) {
 	})
 }
 
+func testAccAwsOrganizationsPolicyAttachment_PolicyTypeNotEnabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_policy_attachment.test"
+	policyIdResourceName := "aws_organizations_policy.test"
+	targetIdResourceName := "aws_organizations_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, organizations.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsOrganizationsPolicyAttachmentConfig_PolicyTypeNotEnabled(rName, false),
+				ExpectError: regexp.MustCompile(`policy type \(RESOURCE_CONTROL_POLICY\) is not enabled in the Organization Root, set enable_policy_type to true`),
+			},
+			{
+				Config: testAccAwsOrganizationsPolicyAttachmentConfig_PolicyTypeNotEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policyIdResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", targetIdResourceName, "roots.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "enable_policy_type", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
 
@@ -291,3 +321,44 @@ resource "aws_organizations_policy_attachment" "test" {
 }
 `, rName)
 }
+
+func testAccAwsOrganizationsPolicyAttachmentConfig_PolicyTypeNotEnabled(rName string, enablePolicyType bool) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  # The policy attachment enables the RESOURCE_CONTROL_POLICY type.
+  lifecycle {
+    ignore_changes = [enabled_policy_types]
+  }
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Deny",
+    "Principal": "*",
+    "Action": "s3:*",
+    "Resource": "*",
+    "Condition": {
+      "BoolIfExists": {
+        "aws:SecureTransport": "false"
+      }
+    }
+  }
+}
+EOF
+
+  name = %[1]q
+  type = "RESOURCE_CONTROL_POLICY"
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  enable_policy_type = %[2]t
+  policy_id          = aws_organizations_policy.test.id
+  target_id          = aws_organizations_organization.test.roots[0].id
+}
+`, rName, enablePolicyType)
+}
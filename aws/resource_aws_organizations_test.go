@@ -37,14 +37,17 @@ func TestAccAWSOrganizations_serial(t *testing.T) {
 			"disappears":             testAccAwsOrganizationsPolicy_disappears,
 			"Type_AI_OPT_OUT":        testAccAwsOrganizationsPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccAwsOrganizationsPolicy_type_Backup,
+			"Type_DeclarativeEC2":    testAccAwsOrganizationsPolicy_type_DeclarativeEC2,
+			"Type_RCP":               testAccAwsOrganizationsPolicy_type_RCP,
 			"Type_SCP":               testAccAwsOrganizationsPolicy_type_SCP,
 			"Type_Tag":               testAccAwsOrganizationsPolicy_type_Tag,
 			"ImportAwsManagedPolicy": testAccAwsOrganizationsPolicy_ImportAwsManagedPolicy,
 		},
 		"PolicyAttachment": {
-			"Account":            testAccAwsOrganizationsPolicyAttachment_Account,
-			"OrganizationalUnit": testAccAwsOrganizationsPolicyAttachment_OrganizationalUnit,
-			"Root":               testAccAwsOrganizationsPolicyAttachment_Root,
+			"Account":              testAccAwsOrganizationsPolicyAttachment_Account,
+			"OrganizationalUnit":   testAccAwsOrganizationsPolicyAttachment_OrganizationalUnit,
+			"PolicyTypeNotEnabled": testAccAwsOrganizationsPolicyAttachment_PolicyTypeNotEnabled,
+			"Root":                 testAccAwsOrganizationsPolicyAttachment_Root,
 		},
 		"DelegatedAdministrator": {
 			"basic":      testAccAwsOrganizationsDelegatedAdministrator_basic,
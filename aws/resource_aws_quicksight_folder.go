@@ -0,0 +1,430 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightFolderCreate,
+		Read:   resourceAwsQuickSightFolderRead,
+		Update: resourceAwsQuickSightFolderUpdate,
+		Delete: resourceAwsQuickSightFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"folder_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"folder_path": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"folder_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      quicksight.FolderTypeShared,
+				ValidateFunc: validation.StringInSlice(quicksight.FolderType_Values(), false),
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+
+			"parent_folder_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsQuickSightFolderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	folderID := d.Get("folder_id").(string)
+	id := tfquicksight.FolderCreateResourceID(awsAccountID, folderID)
+
+	input := &quicksight.CreateFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		FolderType:   aws.String(d.Get("folder_type").(string)),
+		Name:         aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parent_folder_arn"); ok {
+		input.ParentFolderArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightFolderPermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().QuicksightTags()
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Folder: %s", input)
+	_, err := conn.CreateFolder(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating QuickSight Folder (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsQuickSightFolderRead(d, meta)
+}
+
+func resourceAwsQuickSightFolderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	folder, err := finder.FolderByAccountIDAndFolderID(conn, awsAccountID, folderID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Folder (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(folder.Arn)
+	d.Set("arn", arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("created_time", aws.TimeValue(folder.CreatedTime).Format(time.RFC3339))
+	d.Set("folder_id", folder.FolderId)
+	d.Set("folder_type", folder.FolderType)
+	d.Set("last_updated_time", aws.TimeValue(folder.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", folder.Name)
+
+	if err := d.Set("folder_path", aws.StringValueSlice(folder.FolderPath)); err != nil {
+		return fmt.Errorf("error setting folder_path: %w", err)
+	}
+
+	// The last element of the folder path is the immediate parent.
+	if n := len(folder.FolderPath); n > 0 {
+		d.Set("parent_folder_arn", folder.FolderPath[n-1])
+	} else {
+		d.Set("parent_folder_arn", nil)
+	}
+
+	permissions, err := finder.FolderPermissionsByAccountIDAndFolderID(conn, awsAccountID, folderID)
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Folder (%s) permissions: %w", d.Id(), err)
+	}
+
+	if err := d.Set("permissions", flattenQuickSightFolderPermissions(permissions)); err != nil {
+		return fmt.Errorf("error setting permissions: %w", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for QuickSight Folder (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") {
+		input := &quicksight.UpdateFolderInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+			Name:         aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Folder: %s", input)
+		_, err := conn.UpdateFolder(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating QuickSight Folder (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permissions") {
+		o, n := d.GetChange("permissions")
+		toGrant, toRevoke := diffQuickSightFolderPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateFolderPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Folder permissions: %s", input)
+		_, err := conn.UpdateFolderPermissions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating QuickSight Folder (%s) permissions: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %w", err)
+		}
+	}
+
+	return resourceAwsQuickSightFolderRead(d, meta)
+}
+
+func resourceAwsQuickSightFolderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Folder (%s)", d.Id())
+	_, err = conn.DeleteFolder(&quicksight.DeleteFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting QuickSight Folder (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightFolderPermissions(tfList []interface{}) []*quicksight.ResourcePermission {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.ResourcePermission
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.ResourcePermission{
+			Actions:   expandStringSet(tfMap["actions"].(*schema.Set)),
+			Principal: aws.String(tfMap["principal"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightFolderPermissions(apiObjects []*quicksight.ResourcePermission) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"actions":   flattenStringSet(apiObject.Actions),
+			"principal": aws.StringValue(apiObject.Principal),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// diffQuickSightFolderPermissions returns the permissions to grant and to revoke
+// in order to move from the old set of permissions to the new one.
+func diffQuickSightFolderPermissions(o, n []interface{}) ([]*quicksight.ResourcePermission, []*quicksight.ResourcePermission) {
+	oldPermissions := expandQuickSightFolderPermissions(o)
+	newPermissions := expandQuickSightFolderPermissions(n)
+
+	var toGrant, toRevoke []*quicksight.ResourcePermission
+
+	for _, oldPermission := range oldPermissions {
+		found := false
+
+		for _, newPermission := range newPermissions {
+			if aws.StringValue(oldPermission.Principal) != aws.StringValue(newPermission.Principal) {
+				continue
+			}
+
+			found = true
+
+			oldActions := flattenStringSet(oldPermission.Actions)
+			newActions := flattenStringSet(newPermission.Actions)
+
+			if v := oldActions.Difference(newActions); v.Len() > 0 {
+				toRevoke = append(toRevoke, &quicksight.ResourcePermission{
+					Actions:   expandStringSet(v),
+					Principal: oldPermission.Principal,
+				})
+			}
+
+			if v := newActions.Difference(oldActions); v.Len() > 0 {
+				toGrant = append(toGrant, &quicksight.ResourcePermission{
+					Actions:   expandStringSet(v),
+					Principal: newPermission.Principal,
+				})
+			}
+
+			break
+		}
+
+		if !found {
+			toRevoke = append(toRevoke, oldPermission)
+		}
+	}
+
+	for _, newPermission := range newPermissions {
+		found := false
+
+		for _, oldPermission := range oldPermissions {
+			if aws.StringValue(oldPermission.Principal) == aws.StringValue(newPermission.Principal) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			toGrant = append(toGrant, newPermission)
+		}
+	}
+
+	return toGrant, toRevoke
+}
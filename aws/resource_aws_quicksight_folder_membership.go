@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightFolderMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightFolderMembershipCreate,
+		Read:   resourceAwsQuickSightFolderMembershipRead,
+		Delete: resourceAwsQuickSightFolderMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(tfquicksight.MemberType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightFolderMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	folderID := d.Get("folder_id").(string)
+	memberID := d.Get("member_id").(string)
+	memberType := d.Get("member_type").(string)
+	id := tfquicksight.FolderMembershipCreateResourceID(awsAccountID, folderID, memberType, memberID)
+
+	input := &quicksight.CreateFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		MemberId:     aws.String(memberID),
+		MemberType:   aws.String(memberType),
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Folder Membership: %s", input)
+	_, err := conn.CreateFolderMembership(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating QuickSight Folder Membership (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsQuickSightFolderMembershipRead(d, meta)
+}
+
+func resourceAwsQuickSightFolderMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, memberType, memberID, err := tfquicksight.FolderMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = finder.FolderMembershipByID(conn, awsAccountID, folderID, memberType, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Folder Membership (%s): %w", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("folder_id", folderID)
+	d.Set("member_id", memberID)
+	d.Set("member_type", memberType)
+
+	return nil
+}
+
+func resourceAwsQuickSightFolderMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, memberType, memberID, err := tfquicksight.FolderMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Folder Membership (%s)", d.Id())
+	_, err = conn.DeleteFolderMembership(&quicksight.DeleteFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		MemberId:     aws.String(memberID),
+		MemberType:   aws.String(memberType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting QuickSight Folder Membership (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// QuickSight data sets cannot yet be managed by this provider, so the
+// acceptance tests add an existing data set to a new folder.
+const quickSightFolderMembershipDataSetIDEnvVar = "QUICKSIGHT_DATA_SET_ID"
+
+func TestAccAWSQuickSightFolderMembership_basic(t *testing.T) {
+	resourceName := "aws_quicksight_folder_membership.test"
+	folderResourceName := "aws_quicksight_folder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dataSetID := os.Getenv(quickSightFolderMembershipDataSetIDEnvVar)
+	if dataSetID == "" {
+		t.Skipf("%s env missing, skip test", quickSightFolderMembershipDataSetIDEnvVar)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderMembershipConfig(rName, dataSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderMembershipExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_id", folderResourceName, "folder_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", dataSetID),
+					resource.TestCheckResourceAttr(resourceName, "member_type", tfquicksight.MemberTypeDataset),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightFolderMembership_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_folder_membership.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dataSetID := os.Getenv(quickSightFolderMembershipDataSetIDEnvVar)
+	if dataSetID == "" {
+		t.Skipf("%s env missing, skip test", quickSightFolderMembershipDataSetIDEnvVar)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderMembershipConfig(rName, dataSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderMembershipExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightFolderMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightFolderMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Folder Membership ID is set")
+		}
+
+		awsAccountID, folderID, memberType, memberID, err := tfquicksight.FolderMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.FolderMembershipByID(conn, awsAccountID, folderID, memberType, memberID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSQuickSightFolderMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder_membership" {
+			continue
+		}
+
+		awsAccountID, folderID, memberType, memberID, err := tfquicksight.FolderMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.FolderMembershipByID(conn, awsAccountID, folderID, memberType, memberID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightFolderMembershipConfig(rName, dataSetID string) string {
+	return composeConfig(
+		testAccAWSQuickSightFolderConfigName(rName, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_folder_membership" "test" {
+  folder_id   = aws_quicksight_folder.test.folder_id
+  member_id   = %[1]q
+  member_type = "DATASET"
+}
+`, dataSetID))
+}
@@ -0,0 +1,353 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSQuickSightFolder_basic(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("folder/%s", rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "folder_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "folder_type", quicksight.FolderTypeShared),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightFolder_disappears(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightFolder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightFolder_Name(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightFolderConfigName(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightFolder_ParentFolder(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	parentResourceName := "aws_quicksight_folder.parent"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderConfigParentFolder(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_folder_arn", parentResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_path.0", parentResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightFolder_Permissions(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderConfigPermissions(rName, `["quicksight:DescribeFolder"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permissions.*.principal", "aws_quicksight_group.default", "arn"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*.actions.*", "quicksight:DescribeFolder"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightFolderConfigPermissions(rName, `["quicksight:CreateFolder", "quicksight:DescribeFolder", "quicksight:UpdateFolder", "quicksight:DeleteFolder", "quicksight:CreateFolderMembership", "quicksight:DeleteFolderMembership", "quicksight:DescribeFolderPermissions", "quicksight:UpdateFolderPermissions"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*.actions.*", "quicksight:UpdateFolderPermissions"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightFolderConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightFolder_Tags(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightFolderConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightFolderConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightFolderConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightFolderExists(n string, v *quicksight.Folder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Folder ID is set")
+		}
+
+		awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		output, err := finder.FolderByAccountIDAndFolderID(conn, awsAccountID, folderID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSQuickSightFolderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder" {
+			continue
+		}
+
+		awsAccountID, folderID, err := tfquicksight.FolderParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.FolderByAccountIDAndFolderID(conn, awsAccountID, folderID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightFolderConfigName(rName, name string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[2]q
+}
+`, rName, name)
+}
+
+func testAccAWSQuickSightFolderConfigParentFolder(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "parent" {
+  folder_id = "%[1]s-parent"
+  name      = "%[1]s-parent"
+}
+
+resource "aws_quicksight_folder" "test" {
+  folder_id         = %[1]q
+  name              = %[1]q
+  parent_folder_arn = aws_quicksight_folder.parent.arn
+}
+`, rName)
+}
+
+func testAccAWSQuickSightFolderConfigPermissions(rName, actions string) string {
+	return composeConfig(
+		testAccAWSQuickSightGroupConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  permissions {
+    actions   = %[2]s
+    principal = aws_quicksight_group.default.arn
+  }
+}
+`, rName, actions))
+}
+
+func testAccAWSQuickSightFolderConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSQuickSightFolderConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
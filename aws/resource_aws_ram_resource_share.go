@@ -3,12 +3,14 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ram/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ram/waiter"
 )
 
@@ -20,7 +22,7 @@ func resourceAwsRamResourceShare() *schema.Resource {
 		Delete: resourceAwsRamResourceShareDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsRamResourceShareImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -45,6 +47,15 @@ func resourceAwsRamResourceShare() *schema.Resource {
 				Default:  false,
 			},
 
+			"permission_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
 		},
@@ -63,6 +74,10 @@ func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{})
 		AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
 	}
 
+	if v, ok := d.GetOk("permission_arns"); ok && v.(*schema.Set).Len() > 0 {
+		request.PermissionArns = expandStringSet(v.(*schema.Set))
+	}
+
 	if len(tags) > 0 {
 		request.Tags = tags.IgnoreAws().RamTags()
 	}
@@ -121,6 +136,27 @@ func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", resourceShare.Name)
 	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
 
+	permissions, err := finder.ResourceSharePermissionsByShareARN(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading RAM resource share (%s) permissions: %w", d.Id(), err)
+	}
+
+	// RAM automatically associates default permissions for the resource types added to the share,
+	// so only permissions configured in Terraform are tracked.
+	configuredPermissionArns := d.Get("permission_arns").(*schema.Set)
+
+	var permissionArns []string
+	for _, permission := range permissions {
+		if permissionArn := aws.StringValue(permission.Arn); configuredPermissionArns.Contains(permissionArn) {
+			permissionArns = append(permissionArns, permissionArn)
+		}
+	}
+
+	if err := d.Set("permission_arns", permissionArns); err != nil {
+		return fmt.Errorf("error setting permission_arns: %w", err)
+	}
+
 	tags := keyvaluetags.RamKeyValueTags(resourceShare.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -157,6 +193,61 @@ func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("permission_arns") {
+		o, n := d.GetChange("permission_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// A resource type can only have one permission associated, so new permissions replace any existing permission for the same resource type.
+		for _, permissionArn := range ns.Difference(os).List() {
+			input := &ram.AssociateResourceSharePermissionInput{
+				PermissionArn:    aws.String(permissionArn.(string)),
+				Replace:          aws.Bool(true),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Associating RAM resource share permission: %s", input)
+			if _, err := conn.AssociateResourceSharePermission(input); err != nil {
+				return fmt.Errorf("error associating RAM resource share (%s) permission (%s): %w", d.Id(), permissionArn.(string), err)
+			}
+		}
+
+		permissions, err := finder.ResourceSharePermissionsByShareARN(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading RAM resource share (%s) permissions: %w", d.Id(), err)
+		}
+
+		resources, err := finder.ResourceShareResourcesByShareARN(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading RAM resource share (%s) resources: %w", d.Id(), err)
+		}
+
+		for _, permission := range permissions {
+			permissionArn := aws.StringValue(permission.Arn)
+
+			if !os.Contains(permissionArn) || ns.Contains(permissionArn) {
+				continue
+			}
+
+			// RAM does not allow removing the permission for a resource type while resources of that type are shared.
+			if ramResourcesContainType(resources, aws.StringValue(permission.ResourceType)) {
+				log.Printf("[WARN] RAM resource share (%s) has %s resources, not disassociating permission (%s)", d.Id(), aws.StringValue(permission.ResourceType), permissionArn)
+				continue
+			}
+
+			input := &ram.DisassociateResourceSharePermissionInput{
+				PermissionArn:    aws.String(permissionArn),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Disassociating RAM resource share permission: %s", input)
+			if _, err := conn.DisassociateResourceSharePermission(input); err != nil {
+				return fmt.Errorf("error disassociating RAM resource share (%s) permission (%s): %w", d.Id(), permissionArn, err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -192,3 +283,33 @@ func resourceAwsRamResourceShareDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func resourceAwsRamResourceShareImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ramconn
+
+	permissions, err := finder.ResourceSharePermissionsByShareARN(conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading RAM resource share (%s) permissions: %w", d.Id(), err)
+	}
+
+	// Read only keeps configured permissions, so start tracking everything associated with the share.
+	var permissionArns []string
+	for _, permission := range permissions {
+		permissionArns = append(permissionArns, aws.StringValue(permission.Arn))
+	}
+
+	d.Set("permission_arns", permissionArns)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func ramResourcesContainType(resources []*ram.Resource, resourceType string) bool {
+	for _, resource := range resources {
+		if strings.EqualFold(aws.StringValue(resource.Type), resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
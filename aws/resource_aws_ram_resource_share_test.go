@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ram/finder"
 )
 
 func TestAccAwsRamResourceShare_basic(t *testing.T) {
@@ -111,6 +112,96 @@ func TestAccAwsRamResourceShare_Name(t *testing.T) {
 	})
 }
 
+func TestAccAwsRamResourceShare_PermissionArns(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ram.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArns(rName, "AWSRAMDefaultPermissionSubnet"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_arns.*", fmt.Sprintf("arn:%s:ram::aws:permission/AWSRAMDefaultPermissionSubnet", testAccGetPartition())),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArns2(rName, "AWSRAMDefaultPermissionSubnet", "AWSRAMDefaultPermissionPrefixList"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_arns.*", fmt.Sprintf("arn:%s:ram::aws:permission/AWSRAMDefaultPermissionSubnet", testAccGetPartition())),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_arns.*", fmt.Sprintf("arn:%s:ram::aws:permission/AWSRAMDefaultPermissionPrefixList", testAccGetPartition())),
+				),
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArns(rName, "AWSRAMDefaultPermissionPrefixList"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					testAccCheckAwsRamResourceSharePermissionNotAssociated(resourceName, "AWSRAMDefaultPermissionSubnet"),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_arns.*", fmt.Sprintf("arn:%s:ram::aws:permission/AWSRAMDefaultPermissionPrefixList", testAccGetPartition())),
+				),
+			},
+			{
+				Config: testAccAwsRamResourceShareConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					testAccCheckAwsRamResourceSharePermissionNotAssociated(resourceName, "AWSRAMDefaultPermissionPrefixList"),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsRamResourceShare_PermissionArns_ResourceAssociation(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ram.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArnsResourceAssociation(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The permission stays associated while the share has subnets
+			{
+				Config: testAccAwsRamResourceShareConfigPermissionArnsResourceAssociation(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					testAccCheckAwsRamResourceSharePermissionAssociated(resourceName, "AWSRAMDefaultPermissionSubnet"),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsRamResourceShare_Tags(t *testing.T) {
 	var resourceShare1, resourceShare2, resourceShare3 ram.ResourceShare
 	resourceName := "aws_ram_resource_share.test"
@@ -193,6 +284,60 @@ func testAccCheckAwsRamResourceShareExists(resourceName string, resourceShare *r
 	}
 }
 
+func testAccCheckAwsRamResourceSharePermissionAssociated(resourceName, permissionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		permissions, err := finder.ResourceSharePermissionsByShareARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		permissionArn := fmt.Sprintf("arn:%s:ram::aws:permission/%s", testAccGetPartition(), permissionName)
+
+		for _, permission := range permissions {
+			if aws.StringValue(permission.Arn) == permissionArn {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RAM resource share (%s) permission (%s) not associated", rs.Primary.ID, permissionArn)
+	}
+}
+
+func testAccCheckAwsRamResourceSharePermissionNotAssociated(resourceName, permissionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+		permissions, err := finder.ResourceSharePermissionsByShareARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		permissionArn := fmt.Sprintf("arn:%s:ram::aws:permission/%s", testAccGetPartition(), permissionName)
+
+		for _, permission := range permissions {
+			if aws.StringValue(permission.Arn) == permissionArn {
+				return fmt.Errorf("RAM resource share (%s) permission (%s) still associated", rs.Primary.ID, permissionArn)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAwsRamResourceShareDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ramconn
 
@@ -239,6 +384,71 @@ resource "aws_ram_resource_share" "test" {
 `, rName)
 }
 
+func testAccAwsRamResourceShareConfigPermissionArns(rName, permissionName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ram_resource_share" "test" {
+  name            = %[1]q
+  permission_arns = ["arn:${data.aws_partition.current.partition}:ram::aws:permission/%[2]s"]
+}
+`, rName, permissionName)
+}
+
+func testAccAwsRamResourceShareConfigPermissionArns2(rName, permissionName1, permissionName2 string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+
+  permission_arns = [
+    "arn:${data.aws_partition.current.partition}:ram::aws:permission/%[2]s",
+    "arn:${data.aws_partition.current.partition}:ram::aws:permission/%[3]s",
+  ]
+}
+`, rName, permissionName1, permissionName2)
+}
+
+func testAccAwsRamResourceShareConfigPermissionArnsResourceAssociation(rName string, configurePermission bool) string {
+	permissionArns := ""
+	if configurePermission {
+		permissionArns = `permission_arns = ["arn:${data.aws_partition.current.partition}:ram::aws:permission/AWSRAMDefaultPermissionSubnet"]`
+	}
+
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+
+  %[2]s
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_subnet.test.arn
+  resource_share_arn = aws_ram_resource_share.test.id
+}
+`, rName, permissionArns)
+}
+
 func testAccAwsRamResourceShareConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ram_resource_share" "test" {
@@ -33,7 +33,7 @@ func resourceAwsRoute53ResolverQueryLogConfig() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateRoute53ResolverQueryLogConfigDestinationArn,
 			},
 
 			"name": {
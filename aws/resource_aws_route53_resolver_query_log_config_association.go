@@ -39,8 +39,15 @@ func resourceAwsRoute53ResolverQueryLogConfigAssociation() *schema.Resource {
 func resourceAwsRoute53ResolverQueryLogConfigAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).route53resolverconn
 
+	queryLogConfigID := d.Get("resolver_query_log_config_id").(string)
+
+	// VPCs can only be associated once the query log config has been created.
+	if _, err := waiter.QueryLogConfigCreated(conn, queryLogConfigID); err != nil {
+		return fmt.Errorf("error waiting for Route53 Resolver Query Log Config (%s) to become available: %w", queryLogConfigID, err)
+	}
+
 	input := &route53resolver.AssociateResolverQueryLogConfigInput{
-		ResolverQueryLogConfigId: aws.String(d.Get("resolver_query_log_config_id").(string)),
+		ResolverQueryLogConfigId: aws.String(queryLogConfigID),
 		ResourceId:               aws.String(d.Get("resource_id").(string)),
 	}
 
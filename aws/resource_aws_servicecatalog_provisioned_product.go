@@ -326,6 +326,10 @@ func resourceAwsServiceCatalogProvisionedProductCreate(d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(output.RecordDetail.ProvisionedProductId))
 
+	if _, err := waiter.RecordReady(conn, aws.StringValue(input.AcceptLanguage), aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) provisioning: %w", d.Id(), err)
+	}
+
 	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
 }
 
@@ -387,7 +391,7 @@ func resourceAwsServiceCatalogProvisionedProductRead(d *schema.ResourceData, met
 
 	// tags are only available from the record tied to the provisioned product
 
-	recordOutput, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(detail.LastProvisioningRecordId))
+	recordOutput, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(detail.LastProvisioningRecordId), waiter.RecordReadyTimeout)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Service Catalog Provisioned Product (%s) Record (%s) not found, unable to set tags", d.Id(), aws.StringValue(detail.LastProvisioningRecordId))
@@ -467,8 +471,12 @@ func resourceAwsServiceCatalogProvisionedProductUpdate(d *schema.ResourceData, m
 		}
 	}
 
+	var output *servicecatalog.UpdateProvisionedProductOutput
+
 	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.UpdateProvisionedProduct(input)
+		var err error
+
+		output, err = conn.UpdateProvisionedProduct(input)
 
 		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
 			return resource.RetryableError(err)
@@ -482,13 +490,21 @@ func resourceAwsServiceCatalogProvisionedProductUpdate(d *schema.ResourceData, m
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.UpdateProvisionedProduct(input)
+		output, err = conn.UpdateProvisionedProduct(input)
 	}
 
 	if err != nil {
 		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
 	}
 
+	if output == nil || output.RecordDetail == nil {
+		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): empty response", d.Id())
+	}
+
+	if _, err := waiter.RecordReady(conn, aws.StringValue(input.AcceptLanguage), aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) update: %w", d.Id(), err)
+	}
+
 	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
 }
 
@@ -181,6 +181,24 @@ func TestAccAWSServiceCatalogProvisionedProduct_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSServiceCatalogProvisionedProduct_error(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domain := fmt.Sprintf("http://%s", testAccRandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, servicecatalog.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSServiceCatalogProvisionedProductConfig_error(rName, domain, testAccDefaultEmailAddress),
+				ExpectError: regexp.MustCompile(`(?s)'FAILED'.*last\s+error:\s+\S`),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsServiceCatalogProvisionedProductDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).scconn
 
@@ -223,7 +241,7 @@ func testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName string)
 	}
 }
 
-func testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email string) string {
+func testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email, vpcCidr string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket        = %[1]q
@@ -242,7 +260,7 @@ resource "aws_s3_bucket_object" "test" {
       MyVPC = {
         Type = "AWS::EC2::VPC"
         Properties = {
-          CidrBlock = "10.1.0.0/16"
+          CidrBlock = %[4]q
         }
       }
     }
@@ -320,11 +338,24 @@ resource "aws_servicecatalog_principal_portfolio_association" "test" {
 data "aws_servicecatalog_launch_paths" "test" {
   product_id = aws_servicecatalog_product_portfolio_association.test.product_id # avoid depends_on
 }
-`, rName, domain, email)
+`, rName, domain, email, vpcCidr)
 }
 
 func testAccAWSServiceCatalogProvisionedProductConfig_basic(rName, domain, email string) string {
-	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email),
+	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email, "10.1.0.0/16"),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+}
+`, rName))
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfig_error(rName, domain, email string) string {
+	// An invalid VPC CIDR block passes template validation but fails provisioning
+	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email, "10.1.0.0/8"),
 		fmt.Sprintf(`
 resource "aws_servicecatalog_provisioned_product" "test" {
   name                       = %[1]q
@@ -336,7 +367,7 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 }
 
 func testAccAWSServiceCatalogProvisionedProductConfig_tags(rName, tagKey, tagValue, domain, email string) string {
-	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email),
+	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigTemplateURLBase(rName, domain, email, "10.1.0.0/16"),
 		fmt.Sprintf(`
 resource "aws_servicecatalog_provisioned_product" "test" {
   name                       = %[1]q
@@ -74,6 +74,16 @@ func resourceAwsSnsTopic() *schema.Resource {
 					return json
 				},
 			},
+			"archive_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"application_success_feedback_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -164,6 +174,18 @@ func resourceAwsSnsTopic() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateArn,
 			},
+			"signature_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+			},
+			"tracing_config": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tfsns.TopicTracingConfig_Values(), false),
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -235,6 +257,12 @@ func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if d.HasChange("archive_policy") {
+		_, v := d.GetChange("archive_policy")
+		if err := updateAwsSnsTopicAttribute(d.Id(), "ArchivePolicy", v, snsconn); err != nil {
+			return err
+		}
+	}
 	if d.HasChange("delivery_policy") {
 		_, v := d.GetChange("delivery_policy")
 		if err := updateAwsSnsTopicAttribute(d.Id(), "DeliveryPolicy", v, snsconn); err != nil {
@@ -343,6 +371,18 @@ func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if d.HasChange("signature_version") {
+		_, v := d.GetChange("signature_version")
+		if err := updateAwsSnsTopicAttribute(d.Id(), "SignatureVersion", v, snsconn); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("tracing_config") {
+		_, v := d.GetChange("tracing_config")
+		if err := updateAwsSnsTopicAttribute(d.Id(), "TracingConfig", v, snsconn); err != nil {
+			return err
+		}
+	}
 
 	return resourceAwsSnsTopicRead(d, meta)
 }
@@ -369,6 +409,12 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if d.HasChange("archive_policy") {
+		_, v := d.GetChange("archive_policy")
+		if err := updateAwsSnsTopicAttribute(d.Id(), "ArchivePolicy", v, snsconn); err != nil {
+			return err
+		}
+	}
 	if d.HasChange("delivery_policy") {
 		_, v := d.GetChange("delivery_policy")
 		if err := updateAwsSnsTopicAttribute(d.Id(), "DeliveryPolicy", v, snsconn); err != nil {
@@ -477,6 +523,18 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if d.HasChange("signature_version") {
+		_, v := d.GetChange("signature_version")
+		if err := updateAwsSnsTopicAttribute(d.Id(), "SignatureVersion", v, snsconn); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("tracing_config") {
+		_, v := d.GetChange("tracing_config")
+		if err := updateAwsSnsTopicAttribute(d.Id(), "TracingConfig", v, snsconn); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -515,6 +573,7 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 		// set the string values
 		d.Set("application_failure_feedback_role_arn", attributeOutput.Attributes["ApplicationFailureFeedbackRoleArn"])
 		d.Set("application_success_feedback_role_arn", attributeOutput.Attributes["ApplicationSuccessFeedbackRoleArn"])
+		d.Set("archive_policy", attributeOutput.Attributes["ArchivePolicy"])
 		d.Set("arn", attributeOutput.Attributes["TopicArn"])
 		d.Set("delivery_policy", attributeOutput.Attributes["DeliveryPolicy"])
 		d.Set("display_name", attributeOutput.Attributes["DisplayName"])
@@ -529,6 +588,7 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("firehose_success_feedback_role_arn", attributeOutput.Attributes["FirehoseSuccessFeedbackRoleArn"])
 		d.Set("firehose_failure_feedback_role_arn", attributeOutput.Attributes["FirehoseFailureFeedbackRoleArn"])
 		d.Set("owner", attributeOutput.Attributes["Owner"])
+		d.Set("tracing_config", attributeOutput.Attributes["TracingConfig"])
 
 		// set the boolean values
 		if v, ok := attributeOutput.Attributes["FifoTopic"]; ok && aws.StringValue(v) == "true" {
@@ -588,6 +648,15 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 			}
 			d.Set("firehose_success_feedback_sample_rate", v)
 		}
+
+		vStr = aws.StringValue(attributeOutput.Attributes["SignatureVersion"])
+		if vStr != "" {
+			v, err = strconv.ParseInt(vStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing integer attribute 'SignatureVersion': %w", err)
+			}
+			d.Set("signature_version", v)
+		}
 	}
 
 	d.Set("fifo_topic", fifoTopic)
@@ -106,6 +106,16 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
+			"replay_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			"subscription_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -218,6 +228,7 @@ func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{
 	d.Set("owner_id", attributes["Owner"])
 	d.Set("protocol", attributes["Protocol"])
 	d.Set("redrive_policy", attributes["RedrivePolicy"])
+	d.Set("replay_policy", attributes["ReplayPolicy"])
 	d.Set("subscription_role_arn", attributes["SubscriptionRoleArn"])
 	d.Set("topic_arn", attributes["TopicArn"])
 
@@ -288,6 +299,12 @@ func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if d.HasChange("replay_policy") {
+		if err := snsSubscriptionAttributeUpdate(conn, d.Id(), "ReplayPolicy", d.Get("replay_policy").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSnsTopicSubscriptionRead(d, meta)
 }
 
@@ -321,6 +338,7 @@ func expandSNSSubscriptionAttributes(d *schema.ResourceData) (output map[string]
 	filterPolicy := d.Get("filter_policy").(string)
 	rawMessageDelivery := d.Get("raw_message_delivery").(bool)
 	redrivePolicy := d.Get("redrive_policy").(string)
+	replayPolicy := d.Get("replay_policy").(string)
 	subscriptionRoleARN := d.Get("subscription_role_arn").(string)
 
 	// Collect attributes if available
@@ -346,6 +364,10 @@ func expandSNSSubscriptionAttributes(d *schema.ResourceData) (output map[string]
 		attributes["RedrivePolicy"] = aws.String(redrivePolicy)
 	}
 
+	if replayPolicy != "" {
+		attributes["ReplayPolicy"] = aws.String(replayPolicy)
+	}
+
 	return attributes
 }
 
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -270,6 +271,59 @@ func TestAccAWSSNSTopicSubscription_redrivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccAWSSNSTopicSubscription_replayPolicy(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic_subscription.test"
+	timeResourceName := "time_static.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, sns.EndpointsID),
+		Providers:  testAccProviders,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source: "hashicorp/time",
+			},
+		},
+		CheckDestroy: testAccCheckAWSSNSTopicSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicSubscriptionConfig_replayPolicy(rName, "0m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicSubscriptionExists(resourceName, attributes),
+					testAccCheckAWSSNSTopicSubscriptionReplayPolicyStartingPoint(resourceName, timeResourceName, 0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"confirmation_timeout_in_minutes",
+					"endpoint_auto_confirms",
+				},
+			},
+			// Test attribute update
+			{
+				Config: testAccAWSSNSTopicSubscriptionConfig_replayPolicy(rName, "-1m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicSubscriptionExists(resourceName, attributes),
+					testAccCheckAWSSNSTopicSubscriptionReplayPolicyStartingPoint(resourceName, timeResourceName, -1*time.Minute),
+				),
+			},
+			// Test attribute removal
+			{
+				Config: testAccAWSSNSTopicSubscriptionConfig_replayPolicy(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicSubscriptionExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "replay_policy", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSNSTopicSubscription_rawMessageDelivery(t *testing.T) {
 	attributes := make(map[string]string)
 	resourceName := "aws_sns_topic_subscription.test"
@@ -527,6 +581,25 @@ func testAccCheckAWSSNSTopicSubscriptionExists(n string, attributes map[string]s
 	}
 }
 
+func testAccCheckAWSSNSTopicSubscriptionReplayPolicyStartingPoint(n, timeResourceName string, offset time.Duration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[timeResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", timeResourceName)
+		}
+
+		startingPoint, err := time.Parse(time.RFC3339, rs.Primary.Attributes["rfc3339"])
+
+		if err != nil {
+			return err
+		}
+
+		replayPolicy := fmt.Sprintf(`{"PointType":"Timestamp","StartingPoint":%q}`, startingPoint.Add(offset).Format(time.RFC3339))
+
+		return resource.TestCheckResourceAttr(n, "replay_policy", replayPolicy)(s)
+	}
+}
+
 func testAccCheckAWSSNSTopicSubscriptionDeliveryPolicyAttribute(attributes map[string]string, expectedDeliveryPolicy *snsTopicSubscriptionDeliveryPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		apiDeliveryPolicyJSONString, ok := attributes["DeliveryPolicy"]
@@ -694,6 +767,47 @@ resource "aws_sns_topic_subscription" "test" {
 `, rName, dlqName)
 }
 
+func testAccAWSSNSTopicSubscriptionConfig_replayPolicy(rName, startingPointOffset string) string {
+	replayPolicy := ""
+	if startingPointOffset != "" {
+		replayPolicy = fmt.Sprintf(`replay_policy = jsonencode({
+    PointType     = "Timestamp"
+    StartingPoint = timeadd(time_static.test.rfc3339, %q)
+  })`, startingPointOffset)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "%[1]s.fifo"
+  fifo_topic = true
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = "1"
+  })
+}
+
+# Replay starting points are offsets from a fixed time after the message archive exists
+resource "time_static" "test" {
+  triggers = {
+    topic_arn = aws_sns_topic.test.arn
+  }
+}
+
+resource "aws_sqs_queue" "test" {
+  name       = "%[1]s.fifo"
+  fifo_queue = true
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  topic_arn = aws_sns_topic.test.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.test.arn
+
+  %[2]s
+}
+`, rName, replayPolicy)
+}
+
 func testAccAWSSNSTopicSubscriptionConfig_rawMessageDelivery(rName string, rawMessageDelivery bool) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
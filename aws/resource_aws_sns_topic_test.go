@@ -495,6 +495,108 @@ func TestAccAWSSNSTopic_encryption(t *testing.T) {
 	})
 }
 
+func TestAccAWSSNSTopic_signatureVersion(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sns.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicConfigSignatureVersion(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "signature_version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSNSTopicConfigSignatureVersion(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "signature_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSNSTopic_tracingConfig(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sns.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicConfigTracingConfig(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "tracing_config", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSNSTopicConfigTracingConfig(rName, "PassThrough"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "tracing_config", "PassThrough"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSNSTopic_archivePolicy(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sns.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSTopicConfigArchivePolicy(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":"1"}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSNSTopicConfigArchivePolicy(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":"2"}`),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSNSTopic_tags(t *testing.T) {
 	attributes := make(map[string]string)
 	resourceName := "aws_sns_topic.test"
@@ -971,6 +1073,37 @@ resource "aws_sns_topic" "test" {
 `, rName)
 }
 
+func testAccAWSSNSTopicConfigSignatureVersion(rName string, signatureVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name              = %[1]q
+  signature_version = %[2]d
+}
+`, rName, signatureVersion)
+}
+
+func testAccAWSSNSTopicConfigTracingConfig(rName, tracingConfig string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name           = %[1]q
+  tracing_config = %[2]q
+}
+`, rName, tracingConfig)
+}
+
+func testAccAWSSNSTopicConfigArchivePolicy(rName string, messageRetentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "%[1]s.fifo"
+  fifo_topic = true
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = "%[2]d"
+  })
+}
+`, rName, messageRetentionPeriod)
+}
+
 func testAccAWSSNSTopicConfigWithFIFOContentBasedDeduplication(r string, cbd bool) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
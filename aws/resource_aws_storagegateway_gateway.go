@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -235,6 +236,36 @@ func resourceAwsStorageGatewayGateway() *schema.Resource {
 					},
 				},
 			},
+			"maintenance_start_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day_of_month": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9]|1[0-9]|2[0-8])$`), "must be a day of the month between 1 and 28"),
+						},
+						"day_of_week": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-6]$`), "must be a day of the week between 0 (Sunday) and 6 (Saturday)"),
+						},
+						"hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"minute_of_hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -417,6 +448,16 @@ func resourceAwsStorageGatewayGatewayCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if v, ok := d.GetOk("maintenance_start_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandStorageGatewayGatewayMaintenanceStartTime(v.([]interface{})[0].(map[string]interface{}), d.Id())
+
+		log.Printf("[DEBUG] Storage Gateway Gateway %q setting maintenance start time: %s", d.Id(), input)
+		_, err := conn.UpdateMaintenanceStartTime(input)
+		if err != nil {
+			return fmt.Errorf("error setting Storage Gateway Gateway (%s) maintenance start time: %w", d.Id(), err)
+		}
+	}
+
 	bandwidthInput := &storagegateway.UpdateBandwidthRateLimitInput{
 		GatewayARN: aws.String(d.Id()),
 	}
@@ -569,6 +610,21 @@ func resourceAwsStorageGatewayGatewayRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error setting gateway_network_interface: %w", err)
 	}
 
+	maintenanceStartTimeInput := &storagegateway.DescribeMaintenanceStartTimeInput{
+		GatewayARN: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Storage Gateway maintenance start time: %s", maintenanceStartTimeInput)
+	maintenanceStartTimeOutput, err := conn.DescribeMaintenanceStartTime(maintenanceStartTimeInput)
+	if err != nil && !tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified operation is not supported") &&
+		!tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "This operation is not valid for the specified gateway") {
+		return fmt.Errorf("error reading Storage Gateway maintenance start time: %w", err)
+	}
+
+	if err := d.Set("maintenance_start_time", flattenStorageGatewayGatewayMaintenanceStartTime(maintenanceStartTimeOutput)); err != nil {
+		return fmt.Errorf("error setting maintenance_start_time: %w", err)
+	}
+
 	bandwidthInput := &storagegateway.DescribeBandwidthRateLimitInput{
 		GatewayARN: aws.String(d.Id()),
 	}
@@ -664,6 +720,18 @@ func resourceAwsStorageGatewayGatewayUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("maintenance_start_time") {
+		if v, ok := d.GetOk("maintenance_start_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := expandStorageGatewayGatewayMaintenanceStartTime(v.([]interface{})[0].(map[string]interface{}), d.Id())
+
+			log.Printf("[DEBUG] Storage Gateway Gateway %q updating maintenance start time: %s", d.Id(), input)
+			_, err := conn.UpdateMaintenanceStartTime(input)
+			if err != nil {
+				return fmt.Errorf("error updating Storage Gateway Gateway (%s) maintenance start time: %w", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChanges("average_download_rate_limit_in_bits_per_sec",
 		"average_upload_rate_limit_in_bits_per_sec") {
 
@@ -787,6 +855,47 @@ func flattenStorageGatewayGatewayNetworkInterfaces(nis []*storagegateway.Network
 	return tfList
 }
 
+func expandStorageGatewayGatewayMaintenanceStartTime(tfMap map[string]interface{}, gatewayArn string) *storagegateway.UpdateMaintenanceStartTimeInput {
+	apiObject := &storagegateway.UpdateMaintenanceStartTimeInput{
+		GatewayARN:   aws.String(gatewayArn),
+		HourOfDay:    aws.Int64(int64(tfMap["hour_of_day"].(int))),
+		MinuteOfHour: aws.Int64(int64(tfMap["minute_of_hour"].(int))),
+	}
+
+	if v, ok := tfMap["day_of_month"].(string); ok && v != "" {
+		dayOfMonth, _ := strconv.ParseInt(v, 10, 64)
+		apiObject.DayOfMonth = aws.Int64(dayOfMonth)
+	}
+
+	if v, ok := tfMap["day_of_week"].(string); ok && v != "" {
+		dayOfWeek, _ := strconv.ParseInt(v, 10, 64)
+		apiObject.DayOfWeek = aws.Int64(dayOfWeek)
+	}
+
+	return apiObject
+}
+
+func flattenStorageGatewayGatewayMaintenanceStartTime(apiObject *storagegateway.DescribeMaintenanceStartTimeOutput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"hour_of_day":    aws.Int64Value(apiObject.HourOfDay),
+		"minute_of_hour": aws.Int64Value(apiObject.MinuteOfHour),
+	}
+
+	if v := apiObject.DayOfMonth; v != nil {
+		tfMap["day_of_month"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.DayOfWeek; v != nil {
+		tfMap["day_of_week"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	return []interface{}{tfMap}
+}
+
 // The API returns multiple responses for a missing gateway
 func isAWSErrStorageGatewayGatewayNotFound(err error) bool {
 	if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway was not found.") {
@@ -110,6 +110,10 @@ func resourceAwsWorkspacesWorkspace() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
+							// The timeout is only meaningful for the AUTO_STOP running mode.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return d.Get("workspace_properties.0.running_mode").(string) != workspaces.RunningModeAutoStop
+							},
 							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 								val := v.(int)
 								if val%60 != 0 {
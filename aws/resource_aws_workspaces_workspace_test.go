@@ -258,6 +258,19 @@ func testAccAwsWorkspacesWorkspace_workspaceProperties_runningModeAlwaysOn(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config:   testAccWorkspacesWorkspaceConfig_WorkspacePropertiesD(rName, domain),
+				PlanOnly: true,
+			},
+			// Switching back to AUTO_STOP applies the timeout
+			{
+				Config: testAccWorkspacesWorkspaceConfig_WorkspacePropertiesA(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAutoStop),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
 		},
 	})
 }
@@ -560,6 +573,31 @@ resource "aws_workspaces_workspace" "test" {
 `, rName))
 }
 
+func testAccWorkspacesWorkspaceConfig_WorkspacePropertiesD(rName, domain string) string {
+	return composeConfig(
+		testAccAwsWorkspacesWorkspaceConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = data.aws_workspaces_bundle.test.id
+  directory_id = aws_workspaces_directory.test.id
+
+  # NOTE: WorkSpaces API doesn't allow creating users in the directory.
+  # However, "Administrator"" user is always present in a bare directory.
+  user_name = "Administrator"
+
+  workspace_properties {
+    # NOTE: The timeout is ignored for the ALWAYS_ON running mode.
+    running_mode                              = "ALWAYS_ON"
+    running_mode_auto_stop_timeout_in_minutes = 60
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-workspace-%[1]s"
+  }
+}
+`, rName))
+}
+
 func testAccWorkspacesWorkspaceConfig_WorkspacePropertiesC(rName, domain string) string {
 	return composeConfig(
 		testAccAwsWorkspacesWorkspaceConfig_Prerequisites(rName, domain),
@@ -2361,6 +2361,37 @@ func validateRoute53ResolverName(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+// validateRoute53ResolverQueryLogConfigDestinationArn validates that the value is the ARN of
+// an S3 bucket, a CloudWatch Logs log group or a Kinesis Data Firehose delivery stream.
+func validateRoute53ResolverQueryLogConfigDestinationArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	ws, errors = validateArn(v, k)
+
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	parsedARN, _ := arn.Parse(value)
+
+	switch parsedARN.Service {
+	case "s3":
+		return ws, errors
+	case "logs":
+		if strings.HasPrefix(parsedARN.Resource, "log-group:") {
+			return ws, errors
+		}
+	case "firehose":
+		if strings.HasPrefix(parsedARN.Resource, "deliverystream/") {
+			return ws, errors
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of an S3 bucket, a CloudWatch Logs log group or a Kinesis Data Firehose delivery stream", k, value))
+
+	return ws, errors
+}
+
 func validateEKSClusterName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) < 1 || len(value) > 100 {
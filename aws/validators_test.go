@@ -3116,6 +3116,36 @@ func TestValidateRoute53ResolverName(t *testing.T) {
 	}
 }
 
+func TestValidateRoute53ResolverQueryLogConfigDestinationArn(t *testing.T) {
+	validArns := []string{
+		"arn:aws:s3:::my-bucket",                                                        // lintignore:AWSAT005
+		"arn:aws:s3:::my-bucket/prefix",                                                 // lintignore:AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:log-group:/aws/route53/example",            // lintignore:AWSAT003,AWSAT005
+		"arn:aws:firehose:us-west-2:123456789012:deliverystream/example",                // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:logs:us-gov-west-1:123456789012:log-group:/aws/route53/example", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validArns {
+		_, errors := validateRoute53ResolverQueryLogConfigDestinationArn(v, "destination_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Route 53 Resolver query log config destination ARN: %q", v, errors)
+		}
+	}
+
+	invalidArns := []string{
+		"not-an-arn",
+		"arn:aws:sqs:us-west-2:123456789012:example",              // lintignore:AWSAT003,AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:destination:example", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:firehose:us-west-2:123456789012:example",         // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kinesis:us-west-2:123456789012:stream/example",   // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidArns {
+		_, errors := validateRoute53ResolverQueryLogConfigDestinationArn(v, "destination_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Route 53 Resolver query log config destination ARN", v)
+		}
+	}
+}
+
 func TestCloudWatchEventCustomEventBusName(t *testing.T) {
 	cases := []struct {
 		Value   string